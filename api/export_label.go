@@ -0,0 +1,28 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+)
+
+// LabelFields lists the fields of RepoLabel that are available to the --json flag.
+var LabelFields = []string{
+	"name",
+	"color",
+	"description",
+	"isDefault",
+}
+
+func (l *RepoLabel) ExportData(fields []string) *map[string]interface{} {
+	v := reflect.ValueOf(l).Elem()
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(f, s)
+		})
+		data[f] = sf.Interface()
+	}
+
+	return &data
+}
@@ -19,6 +19,8 @@ func (issue *Issue) ExportData(fields []string) *map[string]interface{} {
 			data[f] = issue.Labels.Nodes
 		case "projectCards":
 			data[f] = issue.ProjectCards.Nodes
+		case "linkedPullRequests":
+			data[f] = issue.LinkedPullRequests.Nodes
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
@@ -75,6 +77,8 @@ func (pr *PullRequest) ExportData(fields []string) *map[string]interface{} {
 			data[f] = pr.ProjectCards.Nodes
 		case "reviews":
 			data[f] = pr.Reviews.Nodes
+		case "latestReviews":
+			data[f] = pr.LatestReviews.Nodes
 		case "files":
 			data[f] = pr.Files.Nodes
 		case "reviewRequests":
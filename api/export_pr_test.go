@@ -75,6 +75,28 @@ func TestIssue_ExportData(t *testing.T) {
 				}
 			`),
 		},
+		{
+			name:   "linked pull requests",
+			fields: []string{"linkedPullRequests"},
+			inputJSON: heredoc.Doc(`
+				{ "linkedPullRequests": { "nodes": [
+					{ "number": 12, "title": "Fix the bug", "url": "https://github.com/OWNER/REPO/pull/12", "state": "MERGED", "isDraft": false }
+				] } }
+			`),
+			outputJSON: heredoc.Doc(`
+				{
+					"linkedPullRequests": [
+						{
+							"number": 12,
+							"title": "Fix the bug",
+							"url": "https://github.com/OWNER/REPO/pull/12",
+							"state": "MERGED",
+							"isDraft": false
+						}
+					]
+				}
+			`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -159,7 +181,14 @@ func TestPullRequest_ExportData(t *testing.T) {
 							"conclusion": "SUCCESS",
 							"startedAt": "2020-08-31T15:44:24+02:00",
 							"completedAt": "2020-08-31T15:45:24+02:00",
-							"detailsUrl": "http://example.com/details"
+							"detailsUrl": "http://example.com/details",
+							"checkSuite": {
+								"workflowRun": {
+									"workflow": {
+										"name": ""
+									}
+								}
+							}
 						}
 					]
 				}
@@ -18,6 +18,7 @@ type Comments struct {
 }
 
 type Comment struct {
+	ID                  string         `json:"id"`
 	Author              Author         `json:"author"`
 	AuthorAssociation   string         `json:"authorAssociation"`
 	Body                string         `json:"body"`
@@ -26,6 +27,7 @@ type Comment struct {
 	IsMinimized         bool           `json:"isMinimized"`
 	MinimizedReason     string         `json:"minimizedReason"`
 	ReactionGroups      ReactionGroups `json:"reactionGroups"`
+	URL                 string         `json:"url"`
 }
 
 type CommentCreateInput struct {
@@ -60,6 +62,72 @@ func CommentCreate(client *Client, repoHost string, params CommentCreateInput) (
 	return mutation.AddComment.CommentEdge.Node.URL, nil
 }
 
+func CommentUpdate(client *Client, repoHost string, commentID string, params CommentCreateInput) (string, error) {
+	var mutation struct {
+		UpdateIssueComment struct {
+			IssueComment struct {
+				URL string
+			}
+		} `graphql:"updateIssueComment(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.UpdateIssueCommentInput{
+			ID:   graphql.ID(commentID),
+			Body: githubv4.String(params.Body),
+		},
+	}
+
+	gql := graphQLClient(client.http, repoHost)
+	err := gql.MutateNamed(context.Background(), "CommentUpdate", &mutation, variables)
+	if err != nil {
+		return "", err
+	}
+
+	return mutation.UpdateIssueComment.IssueComment.URL, nil
+}
+
+// LastComment returns the most recent comment made by login on the object identified by
+// subjectID, or nil if there isn't one.
+func LastComment(client *Client, repoHost, subjectID, login string) (*Comment, error) {
+	type response struct {
+		Node struct {
+			Issue       commentableComments `graphql:"... on Issue"`
+			PullRequest commentableComments `graphql:"... on PullRequest"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	var query response
+	variables := map[string]interface{}{
+		"id": graphql.ID(subjectID),
+	}
+
+	gql := graphQLClient(client.http, repoHost)
+	err := gql.QueryNamed(context.Background(), "LastComment", &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := query.Node.Issue.Comments.Nodes
+	if len(comments) == 0 {
+		comments = query.Node.PullRequest.Comments.Nodes
+	}
+
+	for i := len(comments) - 1; i >= 0; i-- {
+		if comments[i].AuthorLogin() == login {
+			c := comments[i]
+			return &c, nil
+		}
+	}
+	return nil, nil
+}
+
+type commentableComments struct {
+	Comments struct {
+		Nodes []Comment
+	} `graphql:"comments(last: 100)"`
+}
+
 func (c Comment) AuthorLogin() string {
 	return c.Author.Login
 }
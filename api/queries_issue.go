@@ -16,8 +16,9 @@ type IssuesPayload struct {
 }
 
 type IssuesAndTotalCount struct {
-	Issues     []Issue
-	TotalCount int
+	Issues       []Issue
+	TotalCount   int
+	SearchCapped bool
 }
 
 type Issue struct {
@@ -26,6 +27,7 @@ type Issue struct {
 	Title          string
 	URL            string
 	State          string
+	StateReason    string
 	Closed         bool
 	Body           string
 	CreatedAt      time.Time
@@ -38,6 +40,34 @@ type Issue struct {
 	ProjectCards   ProjectCards
 	Milestone      *Milestone
 	ReactionGroups ReactionGroups
+	IsPinned       bool
+	Repository     IssueRepository
+
+	LinkedPullRequests LinkedPullRequests
+}
+
+// LinkedPullRequests holds the pull requests that close an issue, as reported
+// by GitHub's "closedByPullRequestsReferences" connection.
+type LinkedPullRequests struct {
+	Nodes      []LinkedPullRequest
+	TotalCount int
+}
+
+type LinkedPullRequest struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	State   string `json:"state"`
+	IsDraft bool   `json:"isDraft"`
+}
+
+// IssueRepository identifies the repository an issue belongs to, populated
+// when issues are listed across more than one repository (e.g. by --owner).
+type IssueRepository struct {
+	Name  string `json:"name"`
+	Owner struct {
+		Login string `json:"login"`
+	} `json:"owner"`
 }
 
 type Assignees struct {
@@ -245,6 +275,7 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 				id
 				title
 				state
+				stateReason
 				body
 				author {
 					login
@@ -336,29 +367,30 @@ func IssueByNumber(client *Client, repo ghrepo.Interface, number int) (*Issue, e
 	return &resp.Repository.Issue, nil
 }
 
-func IssueClose(client *Client, repo ghrepo.Interface, issue Issue) error {
-	var mutation struct {
-		CloseIssue struct {
-			Issue struct {
-				ID githubv4.ID
+func IssueClose(client *Client, repo ghrepo.Interface, issue Issue, stateReason string) error {
+	query := `
+	mutation IssueClose($input: CloseIssueInput!) {
+		closeIssue(input: $input) {
+			issue {
+				id
 			}
-		} `graphql:"closeIssue(input: $input)"`
-	}
+		}
+	}`
 
-	variables := map[string]interface{}{
-		"input": githubv4.CloseIssueInput{
-			IssueID: issue.ID,
-		},
+	input := map[string]interface{}{
+		"issueId": issue.ID,
 	}
-
-	gql := graphQLClient(client.http, repo.RepoHost())
-	err := gql.MutateNamed(context.Background(), "IssueClose", &mutation, variables)
-
-	if err != nil {
-		return err
+	// Older GHES instances don't know about stateReason; only send it when the
+	// caller actually asked for a specific reason.
+	if stateReason != "" {
+		input["stateReason"] = stateReason
+	}
+	variables := map[string]interface{}{
+		"input": input,
 	}
 
-	return nil
+	result := struct{}{}
+	return client.GraphQL(repo.RepoHost(), query, variables, &result)
 }
 
 func IssueReopen(client *Client, repo ghrepo.Interface, issue Issue) error {
@@ -403,6 +435,190 @@ func IssueDelete(client *Client, repo ghrepo.Interface, issue Issue) error {
 	return err
 }
 
+func IssuePin(client *Client, repo ghrepo.Interface, issue Issue) error {
+	var mutation struct {
+		PinIssue struct {
+			Issue struct {
+				ID githubv4.ID
+			}
+		} `graphql:"pinIssue(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": struct {
+			IssueID githubv4.ID `json:"issueId"`
+		}{
+			IssueID: issue.ID,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	return gql.MutateNamed(context.Background(), "IssuePin", &mutation, variables)
+}
+
+func IssueUnpin(client *Client, repo ghrepo.Interface, issue Issue) error {
+	var mutation struct {
+		UnpinIssue struct {
+			Issue struct {
+				ID githubv4.ID
+			}
+		} `graphql:"unpinIssue(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": struct {
+			IssueID githubv4.ID `json:"issueId"`
+		}{
+			IssueID: issue.ID,
+		},
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	return gql.MutateNamed(context.Background(), "IssueUnpin", &mutation, variables)
+}
+
+// PinnedIssues lists the issues currently pinned in repo, most useful for building a
+// friendly error message when the 3-pinned-issue limit has been hit.
+func PinnedIssues(client *Client, repo ghrepo.Interface) ([]Issue, error) {
+	type response struct {
+		Repository struct {
+			PinnedIssues struct {
+				Nodes []struct {
+					Issue Issue
+				}
+			} `graphql:"pinnedIssues(first: 3)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(repo.RepoOwner()),
+		"repo":  githubv4.String(repo.RepoName()),
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	var resp response
+	err := gql.QueryNamed(context.Background(), "PinnedIssues", &resp, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]Issue, len(resp.Repository.PinnedIssues.Nodes))
+	for i, n := range resp.Repository.PinnedIssues.Nodes {
+		issues[i] = n.Issue
+	}
+	return issues, nil
+}
+
+// LinkedBranch is a git branch created from, and associated with, an issue via the
+// createLinkedBranch mutation.
+type LinkedBranch struct {
+	Name string
+	URL  string
+}
+
+// IssueCreateLinkedBranch creates a branch named branchName (or a name chosen by GitHub when
+// branchName is empty) starting from baseBranch, and links it to issue so that the issue shows
+// the branch as "in progress".
+func IssueCreateLinkedBranch(client *Client, repo ghrepo.Interface, issue Issue, baseBranch, branchName string) (*LinkedBranch, error) {
+	var query struct {
+		Repository struct {
+			ID  string
+			Ref *struct {
+				Target struct {
+					Oid string
+				}
+			} `graphql:"ref(qualifiedName: $baseBranch)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":      githubv4.String(repo.RepoOwner()),
+		"repo":       githubv4.String(repo.RepoName()),
+		"baseBranch": githubv4.String(baseBranch),
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	if err := gql.QueryNamed(context.Background(), "IssueDevelopBaseBranch", &query, variables); err != nil {
+		return nil, err
+	}
+	if query.Repository.Ref == nil {
+		return nil, fmt.Errorf("could not find branch %q", baseBranch)
+	}
+
+	var mutation struct {
+		CreateLinkedBranch struct {
+			LinkedBranch struct {
+				Ref struct {
+					Name string
+				}
+			}
+		} `graphql:"createLinkedBranch(input: $input)"`
+	}
+
+	input := struct {
+		IssueID      githubv4.ID          `json:"issueId"`
+		RepositoryID githubv4.ID          `json:"repositoryId"`
+		Oid          githubv4.GitObjectID `json:"oid"`
+		Name         *githubv4.String     `json:"name,omitempty"`
+	}{
+		IssueID:      issue.ID,
+		RepositoryID: query.Repository.ID,
+		Oid:          githubv4.GitObjectID(query.Repository.Ref.Target.Oid),
+	}
+	if branchName != "" {
+		name := githubv4.String(branchName)
+		input.Name = &name
+	}
+
+	if err := gql.MutateNamed(context.Background(), "IssueCreateLinkedBranch", &mutation, map[string]interface{}{"input": input}); err != nil {
+		return nil, err
+	}
+
+	name := mutation.CreateLinkedBranch.LinkedBranch.Ref.Name
+	return &LinkedBranch{
+		Name: name,
+		URL:  fmt.Sprintf("https://%s/%s/tree/%s", repo.RepoHost(), ghrepo.FullName(repo), name),
+	}, nil
+}
+
+// IssueLinkedBranches returns the git branches currently linked to issue via GitHub's
+// "linked branches" feature.
+func IssueLinkedBranches(client *Client, repo ghrepo.Interface, issue Issue) ([]LinkedBranch, error) {
+	var query struct {
+		Repository struct {
+			Issue struct {
+				LinkedBranches struct {
+					Nodes []struct {
+						Ref struct {
+							Name string
+						}
+					}
+				} `graphql:"linkedBranches(first: 100)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(repo.RepoOwner()),
+		"repo":   githubv4.String(repo.RepoName()),
+		"number": githubv4.Int(issue.Number),
+	}
+
+	gql := graphQLClient(client.http, repo.RepoHost())
+	if err := gql.QueryNamed(context.Background(), "IssueLinkedBranches", &query, variables); err != nil {
+		return nil, err
+	}
+
+	branches := make([]LinkedBranch, len(query.Repository.Issue.LinkedBranches.Nodes))
+	for i, n := range query.Repository.Issue.LinkedBranches.Nodes {
+		branches[i] = LinkedBranch{
+			Name: n.Ref.Name,
+			URL:  fmt.Sprintf("https://%s/%s/tree/%s", repo.RepoHost(), ghrepo.FullName(repo), n.Ref.Name),
+		}
+	}
+	return branches, nil
+}
+
 func IssueUpdate(client *Client, repo ghrepo.Interface, params githubv4.UpdateIssueInput) error {
 	var mutation struct {
 		UpdateIssue struct {
@@ -7,8 +7,8 @@ import (
 	"github.com/shurcooL/githubv4"
 )
 
-// OrganizationProjects fetches all open projects for an organization
-func OrganizationProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, error) {
+// OrganizationProjects fetches open projects for an organization, up to RepoMetadataListLimit
+func OrganizationProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, bool, error) {
 	type responseData struct {
 		Organization struct {
 			Projects struct {
@@ -33,17 +33,20 @@ func OrganizationProjects(client *Client, repo ghrepo.Interface) ([]RepoProject,
 		var query responseData
 		err := gql.QueryNamed(context.Background(), "OrganizationProjectList", &query, variables)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		projects = append(projects, query.Organization.Projects.Nodes...)
+		if len(projects) >= RepoMetadataListLimit {
+			return projects[:RepoMetadataListLimit], query.Organization.Projects.PageInfo.HasNextPage, nil
+		}
 		if !query.Organization.Projects.PageInfo.HasNextPage {
 			break
 		}
 		variables["endCursor"] = githubv4.String(query.Organization.Projects.PageInfo.EndCursor)
 	}
 
-	return projects, nil
+	return projects, false, nil
 }
 
 type OrgTeam struct {
@@ -51,8 +54,8 @@ type OrgTeam struct {
 	Slug string
 }
 
-// OrganizationTeams fetches all the teams in an organization
-func OrganizationTeams(client *Client, repo ghrepo.Interface) ([]OrgTeam, error) {
+// OrganizationTeams fetches the teams in an organization, up to RepoMetadataListLimit
+func OrganizationTeams(client *Client, repo ghrepo.Interface) ([]OrgTeam, bool, error) {
 	type responseData struct {
 		Organization struct {
 			Teams struct {
@@ -77,15 +80,18 @@ func OrganizationTeams(client *Client, repo ghrepo.Interface) ([]OrgTeam, error)
 		var query responseData
 		err := gql.QueryNamed(context.Background(), "OrganizationTeamList", &query, variables)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		teams = append(teams, query.Organization.Teams.Nodes...)
+		if len(teams) >= RepoMetadataListLimit {
+			return teams[:RepoMetadataListLimit], query.Organization.Teams.PageInfo.HasNextPage, nil
+		}
 		if !query.Organization.Teams.PageInfo.HasNextPage {
 			break
 		}
 		variables["endCursor"] = githubv4.String(query.Organization.Teams.PageInfo.EndCursor)
 	}
 
-	return teams, nil
+	return teams, false, nil
 }
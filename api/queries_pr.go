@@ -19,6 +19,7 @@ import (
 type PullRequestsPayload struct {
 	ViewerCreated   PullRequestAndTotalCount
 	ReviewRequested PullRequestAndTotalCount
+	Mentioning      PullRequestAndTotalCount
 	CurrentPR       *PullRequest
 	DefaultBranch   string
 }
@@ -26,6 +27,7 @@ type PullRequestsPayload struct {
 type PullRequestAndTotalCount struct {
 	TotalCount   int
 	PullRequests []PullRequest
+	SearchCapped bool
 }
 
 type PullRequest struct {
@@ -91,6 +93,13 @@ type PullRequest struct {
 							CompletedAt time.Time `json:"completedAt"`
 							DetailsURL  string    `json:"detailsUrl"`
 							TargetURL   string    `json:"targetUrl,omitempty"`
+							CheckSuite  struct {
+								WorkflowRun struct {
+									Workflow struct {
+										Name string `json:"name"`
+									} `json:"workflow"`
+								} `json:"workflowRun"`
+							} `json:"checkSuite"`
 						}
 						PageInfo struct {
 							HasNextPage bool
@@ -109,6 +118,7 @@ type PullRequest struct {
 	Comments       Comments
 	ReactionGroups ReactionGroups
 	Reviews        PullRequestReviews
+	LatestReviews  PullRequestReviews
 	ReviewRequests ReviewRequests
 }
 
@@ -290,6 +300,23 @@ func (c Client) PullRequestDiff(baseRepo ghrepo.Interface, prNumber int) (io.Rea
 	return resp.Body, nil
 }
 
+type ChangedFile struct {
+	Path   string `json:"filename"`
+	Status string `json:"status"`
+}
+
+func (c Client) PullRequestChangedFiles(baseRepo ghrepo.Interface, prNumber int) ([]ChangedFile, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/files?per_page=100", ghrepo.FullName(baseRepo), prNumber)
+
+	var files []ChangedFile
+	err := c.REST(baseRepo.RepoHost(), "GET", path, nil, &files)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
 type pullRequestFeature struct {
 	HasReviewDecision       bool
 	HasStatusCheckRollup    bool
@@ -387,6 +414,7 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		}
 		ViewerCreated   edges
 		ReviewRequested edges
+		Mentioning      edges
 	}
 
 	var fragments string
@@ -406,7 +434,7 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 	}
 
 	queryPrefix := `
-	query PullRequestStatus($owner: String!, $repo: String!, $headRefName: String!, $viewerQuery: String!, $reviewerQuery: String!, $per_page: Int = 10) {
+	query PullRequestStatus($owner: String!, $repo: String!, $headRefName: String!, $viewerQuery: String!, $reviewerQuery: String!, $mentionsQuery: String!, $per_page: Int = 10) {
 		repository(owner: $owner, name: $repo) {
 			defaultBranchRef {
 				name
@@ -423,7 +451,7 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 	`
 	if options.CurrentPR > 0 {
 		queryPrefix = `
-		query PullRequestStatus($owner: String!, $repo: String!, $number: Int!, $viewerQuery: String!, $reviewerQuery: String!, $per_page: Int = 10) {
+		query PullRequestStatus($owner: String!, $repo: String!, $number: Int!, $viewerQuery: String!, $reviewerQuery: String!, $mentionsQuery: String!, $per_page: Int = 10) {
 			repository(owner: $owner, name: $repo) {
 				defaultBranchRef {
 					name
@@ -452,6 +480,14 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
           }
         }
       }
+      mentioning: search(query: $mentionsQuery, type: ISSUE, first: $per_page) {
+        totalCount: issueCount
+        edges {
+          node {
+            ...pr
+          }
+        }
+      }
     }
 	`
 
@@ -466,6 +502,7 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 
 	viewerQuery := fmt.Sprintf("repo:%s state:open is:pr author:%s", ghrepo.FullName(repo), currentUsername)
 	reviewerQuery := fmt.Sprintf("repo:%s state:open review-requested:%s", ghrepo.FullName(repo), currentUsername)
+	mentionsQuery := fmt.Sprintf("repo:%s state:open is:pr mentions:%s", ghrepo.FullName(repo), currentUsername)
 
 	currentPRHeadRef := options.HeadRef
 	branchWithoutOwner := currentPRHeadRef
@@ -476,6 +513,7 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 	variables := map[string]interface{}{
 		"viewerQuery":   viewerQuery,
 		"reviewerQuery": reviewerQuery,
+		"mentionsQuery": mentionsQuery,
 		"owner":         repo.RepoOwner(),
 		"repo":          repo.RepoName(),
 		"headRefName":   branchWithoutOwner,
@@ -498,6 +536,11 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 		reviewRequested = append(reviewRequested, edge.Node)
 	}
 
+	var mentioning []PullRequest
+	for _, edge := range resp.Mentioning.Edges {
+		mentioning = append(mentioning, edge.Node)
+	}
+
 	var currentPR = resp.Repository.PullRequest
 	if currentPR == nil {
 		for _, edge := range resp.Repository.PullRequests.Edges {
@@ -517,6 +560,10 @@ func PullRequestStatus(client *Client, repo ghrepo.Interface, options StatusOpti
 			PullRequests: reviewRequested,
 			TotalCount:   resp.ReviewRequested.TotalCount,
 		},
+		Mentioning: PullRequestAndTotalCount{
+			PullRequests: mentioning,
+			TotalCount:   resp.Mentioning.TotalCount,
+		},
 		CurrentPR:     currentPR,
 		DefaultBranch: resp.Repository.DefaultBranchRef.Name,
 	}
@@ -787,6 +834,41 @@ func PullRequestReady(client *Client, repo ghrepo.Interface, pr *PullRequest) er
 	return gql.MutateNamed(context.Background(), "PullRequestReadyForReview", &mutation, variables)
 }
 
+// PullRequestExists reports whether number refers to a pull request in repo, as opposed to an
+// issue or a number that doesn't exist at all.
+func PullRequestExists(client *Client, repo ghrepo.Interface, number int) (bool, error) {
+	type response struct {
+		Repository struct {
+			PullRequest *struct {
+				ID string
+			}
+		}
+	}
+
+	query := `
+	query PullRequestExists($owner: String!, $repo: String!, $pr_number: Int!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequest(number: $pr_number) {
+				id
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":     repo.RepoOwner(),
+		"repo":      repo.RepoName(),
+		"pr_number": number,
+	}
+
+	var resp response
+	err := client.GraphQL(repo.RepoHost(), query, variables, &resp)
+	if err != nil {
+		return false, err
+	}
+
+	return resp.Repository.PullRequest != nil, nil
+}
+
 func BranchDeleteRemote(client *Client, repo ghrepo.Interface, branch string) error {
 	path := fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", repo.RepoOwner(), repo.RepoName(), branch)
 	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
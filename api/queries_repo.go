@@ -477,6 +477,19 @@ func ForkRepo(client *Client, repo ghrepo.Interface, org string) (*Repository, e
 	}, nil
 }
 
+// EditRepoArchival sets the archived state of a repository
+func EditRepoArchival(client *Client, repo ghrepo.Interface, archived bool) error {
+	path := fmt.Sprintf("repos/%s", ghrepo.FullName(repo))
+
+	body := &bytes.Buffer{}
+	enc := json.NewEncoder(body)
+	if err := enc.Encode(map[string]bool{"archived": archived}); err != nil {
+		return err
+	}
+
+	return client.REST(repo.RepoHost(), "PATCH", path, body, nil)
+}
+
 // RepoFindForks finds forks of the repo that are affiliated with the viewer
 func RepoFindForks(client *Client, repo ghrepo.Interface, limit int) ([]*Repository, error) {
 	result := struct {
@@ -525,12 +538,21 @@ func RepoFindForks(client *Client, repo ghrepo.Interface, limit int) ([]*Reposit
 	return results, nil
 }
 
+// RepoMetadataListLimit caps how many items are fetched for a single metadata
+// category (assignable users, labels, projects, milestones) so that a repository
+// with an unusually large number of them doesn't make `RepoMetadata` paginate forever.
+const RepoMetadataListLimit = 1000
+
 type RepoMetadataResult struct {
 	AssignableUsers []RepoAssignee
 	Labels          []RepoLabel
 	Projects        []RepoProject
 	Milestones      []RepoMilestone
 	Teams           []OrgTeam
+	// Truncated lists the metadata categories for which more than
+	// RepoMetadataListLimit items were available but only the first
+	// RepoMetadataListLimit were fetched.
+	Truncated []string
 }
 
 func (m *RepoMetadataResult) MembersToIDs(names []string) ([]string, error) {
@@ -591,16 +613,14 @@ func (m *RepoMetadataResult) LabelsToIDs(names []string) ([]string, error) {
 func (m *RepoMetadataResult) ProjectsToIDs(names []string) ([]string, error) {
 	var ids []string
 	for _, projectName := range names {
-		found := false
-		for _, p := range m.Projects {
-			if strings.EqualFold(projectName, p.Name) {
-				ids = append(ids, p.ID)
-				found = true
-				break
-			}
-		}
-		if !found {
+		matches := projectsByName(m.Projects, projectName)
+		switch len(matches) {
+		case 0:
 			return nil, fmt.Errorf("'%s' not found", projectName)
+		case 1:
+			ids = append(ids, matches[0].ID)
+		default:
+			return nil, ambiguousProjectError(projectName, matches)
 		}
 	}
 	return ids, nil
@@ -609,30 +629,50 @@ func (m *RepoMetadataResult) ProjectsToIDs(names []string) ([]string, error) {
 func ProjectsToPaths(projects []RepoProject, names []string) ([]string, error) {
 	var paths []string
 	for _, projectName := range names {
-		found := false
-		for _, p := range projects {
-			if strings.EqualFold(projectName, p.Name) {
-				// format of ResourcePath: /OWNER/REPO/projects/PROJECT_NUMBER or /orgs/ORG/projects/PROJECT_NUMBER
-				// required format of path: OWNER/REPO/PROJECT_NUMBER or ORG/PROJECT_NUMBER
-				var path string
-				pathParts := strings.Split(p.ResourcePath, "/")
-				if pathParts[1] == "orgs" {
-					path = fmt.Sprintf("%s/%s", pathParts[2], pathParts[4])
-				} else {
-					path = fmt.Sprintf("%s/%s/%s", pathParts[1], pathParts[2], pathParts[4])
-				}
-				paths = append(paths, path)
-				found = true
-				break
-			}
-		}
-		if !found {
+		matches := projectsByName(projects, projectName)
+		switch len(matches) {
+		case 0:
 			return nil, fmt.Errorf("'%s' not found", projectName)
+		case 1:
+			paths = append(paths, projectPath(matches[0]))
+		default:
+			return nil, ambiguousProjectError(projectName, matches)
 		}
 	}
 	return paths, nil
 }
 
+func projectsByName(projects []RepoProject, name string) []RepoProject {
+	var matches []RepoProject
+	for _, p := range projects {
+		if strings.EqualFold(name, p.Name) {
+			matches = append(matches, p)
+		}
+	}
+	return matches
+}
+
+// projectPath converts a project's GraphQL resourcePath (e.g. /OWNER/REPO/projects/NUMBER
+// or /orgs/ORG/projects/NUMBER) into the OWNER/REPO/NUMBER or ORG/NUMBER form expected by
+// the "projects" web query parameter.
+func projectPath(p RepoProject) string {
+	pathParts := strings.Split(p.ResourcePath, "/")
+	if pathParts[1] == "orgs" {
+		return fmt.Sprintf("%s/%s", pathParts[2], pathParts[4])
+	}
+	return fmt.Sprintf("%s/%s/%s", pathParts[1], pathParts[2], pathParts[4])
+}
+
+// ambiguousProjectError reports that a project name matched more than one project, listing
+// the resource paths of the candidates so the caller can disambiguate by picking a specific one.
+func ambiguousProjectError(name string, matches []RepoProject) error {
+	var candidates []string
+	for _, p := range matches {
+		candidates = append(candidates, p.ResourcePath)
+	}
+	return fmt.Errorf("multiple projects match %q on the server, disambiguate using one of:\n%s", name, strings.Join(candidates, "\n"))
+}
+
 func (m *RepoMetadataResult) MilestoneToID(title string) (string, error) {
 	for _, m := range m.Milestones {
 		if strings.EqualFold(title, m.Title) {
@@ -662,6 +702,8 @@ func (m *RepoMetadataResult) Merge(m2 *RepoMetadataResult) {
 	if len(m2.Milestones) > 0 || len(m.Milestones) == 0 {
 		m.Milestones = m2.Milestones
 	}
+
+	m.Truncated = append(m.Truncated, m2.Truncated...)
 }
 
 type RepoMetadataInput struct {
@@ -676,69 +718,80 @@ type RepoMetadataInput struct {
 func RepoMetadata(client *Client, repo ghrepo.Interface, input RepoMetadataInput) (*RepoMetadataResult, error) {
 	result := RepoMetadataResult{}
 	errc := make(chan error)
+	truncc := make(chan string)
 	count := 0
 
 	if input.Assignees || input.Reviewers {
 		count++
 		go func() {
-			users, err := RepoAssignableUsers(client, repo)
+			users, truncated, err := RepoAssignableUsers(client, repo)
 			if err != nil {
 				err = fmt.Errorf("error fetching assignees: %w", err)
 			}
 			result.AssignableUsers = users
+			truncc <- truncatedLabel("assignees", truncated)
 			errc <- err
 		}()
 	}
 	if input.Reviewers {
 		count++
 		go func() {
-			teams, err := OrganizationTeams(client, repo)
+			teams, truncated, err := OrganizationTeams(client, repo)
 			// TODO: better detection of non-org repos
 			if err != nil && !strings.HasPrefix(err.Error(), "Could not resolve to an Organization") {
+				truncc <- ""
 				errc <- fmt.Errorf("error fetching organization teams: %w", err)
 				return
 			}
 			result.Teams = teams
+			truncc <- truncatedLabel("teams", truncated)
 			errc <- nil
 		}()
 	}
 	if input.Labels {
 		count++
 		go func() {
-			labels, err := RepoLabels(client, repo)
+			labels, truncated, err := RepoLabels(client, repo)
 			if err != nil {
 				err = fmt.Errorf("error fetching labels: %w", err)
 			}
 			result.Labels = labels
+			truncc <- truncatedLabel("labels", truncated)
 			errc <- err
 		}()
 	}
 	if input.Projects {
 		count++
 		go func() {
-			projects, err := RepoAndOrgProjects(client, repo)
+			projects, truncated, err := RepoAndOrgProjects(client, repo)
 			if err != nil {
+				truncc <- ""
 				errc <- err
 				return
 			}
 			result.Projects = projects
+			truncc <- truncatedLabel("projects", truncated)
 			errc <- nil
 		}()
 	}
 	if input.Milestones {
 		count++
 		go func() {
-			milestones, err := RepoMilestones(client, repo, "open")
+			milestones, truncated, err := RepoMilestones(client, repo, "open")
 			if err != nil {
 				err = fmt.Errorf("error fetching milestones: %w", err)
 			}
 			result.Milestones = milestones
+			truncc <- truncatedLabel("milestones", truncated)
 			errc <- err
 		}()
 	}
 
 	var err error
 	for i := 0; i < count; i++ {
+		if label := <-truncc; label != "" {
+			result.Truncated = append(result.Truncated, label)
+		}
 		if e := <-errc; e != nil {
 			err = e
 		}
@@ -747,6 +800,13 @@ func RepoMetadata(client *Client, repo ghrepo.Interface, input RepoMetadataInput
 	return &result, err
 }
 
+func truncatedLabel(category string, truncated bool) string {
+	if !truncated {
+		return ""
+	}
+	return category
+}
+
 type RepoResolveInput struct {
 	Assignees  []string
 	Reviewers  []string
@@ -856,8 +916,8 @@ type RepoProject struct {
 	ResourcePath string `json:"resourcePath"`
 }
 
-// RepoProjects fetches all open projects for a repository
-func RepoProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, error) {
+// RepoProjects fetches open projects for a repository, up to RepoMetadataListLimit
+func RepoProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, bool, error) {
 	type responseData struct {
 		Repository struct {
 			Projects struct {
@@ -883,34 +943,37 @@ func RepoProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, error)
 		var query responseData
 		err := gql.QueryNamed(context.Background(), "RepositoryProjectList", &query, variables)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		projects = append(projects, query.Repository.Projects.Nodes...)
+		if len(projects) >= RepoMetadataListLimit {
+			return projects[:RepoMetadataListLimit], query.Repository.Projects.PageInfo.HasNextPage, nil
+		}
 		if !query.Repository.Projects.PageInfo.HasNextPage {
 			break
 		}
 		variables["endCursor"] = githubv4.String(query.Repository.Projects.PageInfo.EndCursor)
 	}
 
-	return projects, nil
+	return projects, false, nil
 }
 
-// RepoAndOrgProjects fetches all open projects for a repository and its org
-func RepoAndOrgProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, error) {
-	projects, err := RepoProjects(client, repo)
+// RepoAndOrgProjects fetches open projects for a repository and its org, up to RepoMetadataListLimit
+func RepoAndOrgProjects(client *Client, repo ghrepo.Interface) ([]RepoProject, bool, error) {
+	projects, truncated, err := RepoProjects(client, repo)
 	if err != nil {
-		return projects, fmt.Errorf("error fetching projects: %w", err)
+		return projects, truncated, fmt.Errorf("error fetching projects: %w", err)
 	}
 
-	orgProjects, err := OrganizationProjects(client, repo)
+	orgProjects, orgTruncated, err := OrganizationProjects(client, repo)
 	// TODO: better detection of non-org repos
 	if err != nil && !strings.HasPrefix(err.Error(), "Could not resolve to an Organization") {
-		return projects, fmt.Errorf("error fetching organization projects: %w", err)
+		return projects, truncated, fmt.Errorf("error fetching organization projects: %w", err)
 	}
 	projects = append(projects, orgProjects...)
 
-	return projects, nil
+	return projects, truncated || orgTruncated, nil
 }
 
 type RepoAssignee struct {
@@ -918,8 +981,8 @@ type RepoAssignee struct {
 	Login string
 }
 
-// RepoAssignableUsers fetches all the assignable users for a repository
-func RepoAssignableUsers(client *Client, repo ghrepo.Interface) ([]RepoAssignee, error) {
+// RepoAssignableUsers fetches the assignable users for a repository, up to RepoMetadataListLimit
+func RepoAssignableUsers(client *Client, repo ghrepo.Interface) ([]RepoAssignee, bool, error) {
 	type responseData struct {
 		Repository struct {
 			AssignableUsers struct {
@@ -945,26 +1008,32 @@ func RepoAssignableUsers(client *Client, repo ghrepo.Interface) ([]RepoAssignee,
 		var query responseData
 		err := gql.QueryNamed(context.Background(), "RepositoryAssignableUsers", &query, variables)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		users = append(users, query.Repository.AssignableUsers.Nodes...)
+		if len(users) >= RepoMetadataListLimit {
+			return users[:RepoMetadataListLimit], query.Repository.AssignableUsers.PageInfo.HasNextPage, nil
+		}
 		if !query.Repository.AssignableUsers.PageInfo.HasNextPage {
 			break
 		}
 		variables["endCursor"] = githubv4.String(query.Repository.AssignableUsers.PageInfo.EndCursor)
 	}
 
-	return users, nil
+	return users, false, nil
 }
 
 type RepoLabel struct {
-	ID   string
-	Name string
+	ID          string
+	Name        string
+	Color       string
+	Description string
+	IsDefault   bool
 }
 
-// RepoLabels fetches all the labels in a repository
-func RepoLabels(client *Client, repo ghrepo.Interface) ([]RepoLabel, error) {
+// RepoLabels fetches the labels in a repository, up to RepoMetadataListLimit
+func RepoLabels(client *Client, repo ghrepo.Interface) ([]RepoLabel, bool, error) {
 	type responseData struct {
 		Repository struct {
 			Labels struct {
@@ -990,17 +1059,20 @@ func RepoLabels(client *Client, repo ghrepo.Interface) ([]RepoLabel, error) {
 		var query responseData
 		err := gql.QueryNamed(context.Background(), "RepositoryLabelList", &query, variables)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		labels = append(labels, query.Repository.Labels.Nodes...)
+		if len(labels) >= RepoMetadataListLimit {
+			return labels[:RepoMetadataListLimit], query.Repository.Labels.PageInfo.HasNextPage, nil
+		}
 		if !query.Repository.Labels.PageInfo.HasNextPage {
 			break
 		}
 		variables["endCursor"] = githubv4.String(query.Repository.Labels.PageInfo.EndCursor)
 	}
 
-	return labels, nil
+	return labels, false, nil
 }
 
 type RepoMilestone struct {
@@ -1008,8 +1080,8 @@ type RepoMilestone struct {
 	Title string
 }
 
-// RepoMilestones fetches milestones in a repository
-func RepoMilestones(client *Client, repo ghrepo.Interface, state string) ([]RepoMilestone, error) {
+// RepoMilestones fetches milestones in a repository, up to RepoMetadataListLimit
+func RepoMilestones(client *Client, repo ghrepo.Interface, state string) ([]RepoMilestone, bool, error) {
 	type responseData struct {
 		Repository struct {
 			Milestones struct {
@@ -1031,7 +1103,7 @@ func RepoMilestones(client *Client, repo ghrepo.Interface, state string) ([]Repo
 	case "all":
 		states = []githubv4.MilestoneState{"OPEN", "CLOSED"}
 	default:
-		return nil, fmt.Errorf("invalid state: %s", state)
+		return nil, false, fmt.Errorf("invalid state: %s", state)
 	}
 
 	variables := map[string]interface{}{
@@ -1048,31 +1120,47 @@ func RepoMilestones(client *Client, repo ghrepo.Interface, state string) ([]Repo
 		var query responseData
 		err := gql.QueryNamed(context.Background(), "RepositoryMilestoneList", &query, variables)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		milestones = append(milestones, query.Repository.Milestones.Nodes...)
+		if len(milestones) >= RepoMetadataListLimit {
+			return milestones[:RepoMetadataListLimit], query.Repository.Milestones.PageInfo.HasNextPage, nil
+		}
 		if !query.Repository.Milestones.PageInfo.HasNextPage {
 			break
 		}
 		variables["endCursor"] = githubv4.String(query.Repository.Milestones.PageInfo.EndCursor)
 	}
 
-	return milestones, nil
+	return milestones, false, nil
 }
 
 func MilestoneByTitle(client *Client, repo ghrepo.Interface, state, title string) (*RepoMilestone, error) {
-	milestones, err := RepoMilestones(client, repo, state)
+	milestones, _, err := RepoMilestones(client, repo, state)
 	if err != nil {
 		return nil, err
 	}
 
+	var matches []RepoMilestone
 	for i := range milestones {
 		if strings.EqualFold(milestones[i].Title, title) {
-			return &milestones[i], nil
+			matches = append(matches, milestones[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no milestone found with title %q", title)
+	case 1:
+		return &matches[0], nil
+	default:
+		var titles []string
+		for _, m := range matches {
+			titles = append(titles, fmt.Sprintf("%q", m.Title))
 		}
+		return nil, fmt.Errorf("more than one milestone matches %q, use one of: %s", title, strings.Join(titles, ", "))
 	}
-	return nil, fmt.Errorf("no milestone found with title %q", title)
 }
 
 func MilestoneByNumber(client *Client, repo ghrepo.Interface, number int32) (*RepoMilestone, error) {
@@ -1103,7 +1191,7 @@ func MilestoneByNumber(client *Client, repo ghrepo.Interface, number int32) (*Re
 
 func ProjectNamesToPaths(client *Client, repo ghrepo.Interface, projectNames []string) ([]string, error) {
 	var paths []string
-	projects, err := RepoAndOrgProjects(client, repo)
+	projects, _, err := RepoAndOrgProjects(client, repo)
 	if err != nil {
 		return paths, err
 	}
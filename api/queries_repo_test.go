@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -198,6 +199,44 @@ func Test_ProjectNamesToPaths(t *testing.T) {
 	}
 }
 
+func Test_ProjectNamesToPaths_ambiguous(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := NewClient(ReplaceTripper(http))
+
+	repo, _ := ghrepo.FromFullName("OWNER/REPO")
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryProjectList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "projects": {
+			"nodes": [
+				{ "name": "Roadmap", "id": "REPOID", "resourcePath": "/OWNER/REPO/projects/1" }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	http.Register(
+		httpmock.GraphQL(`query OrganizationProjectList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "organization": { "projects": {
+				"nodes": [
+					{ "name": "Roadmap", "id": "ORGID", "resourcePath": "/orgs/OWNER/projects/2" }
+				],
+				"pageInfo": { "hasNextPage": false }
+			} } } }
+			`))
+
+	_, err := ProjectNamesToPaths(client, repo, []string{"Roadmap"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "multiple projects match") ||
+		!strings.Contains(err.Error(), "/OWNER/REPO/projects/1") ||
+		!strings.Contains(err.Error(), "/orgs/OWNER/projects/2") {
+		t.Errorf("unexpected error message: %s", err)
+	}
+}
+
 func Test_RepoResolveMetadataIDs(t *testing.T) {
 	http := &httpmock.Registry{}
 	client := NewClient(ReplaceTripper(http))
@@ -294,6 +333,30 @@ func sliceEqual(a, b []string) bool {
 	return true
 }
 
+func Test_MilestoneByTitle_ambiguous(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "milestones": {
+			"nodes": [
+				{ "title": "Sprint 1", "id": "MID1" },
+				{ "title": "sprint 1", "id": "MID2" }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	client := NewClient(ReplaceTripper(reg))
+
+	_, err := MilestoneByTitle(client, ghrepo.New("OWNER", "REPO"), "all", "Sprint 1")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), `"Sprint 1"`) || !strings.Contains(err.Error(), `"sprint 1"`) {
+		t.Errorf("error does not list ambiguous titles: %v", err)
+	}
+}
+
 func Test_RepoMilestones(t *testing.T) {
 	tests := []struct {
 		state   string
@@ -331,7 +394,7 @@ func Test_RepoMilestones(t *testing.T) {
 		})
 		client := NewClient(ReplaceTripper(reg))
 
-		_, err := RepoMilestones(client, ghrepo.New("OWNER", "REPO"), tt.state)
+		_, _, err := RepoMilestones(client, ghrepo.New("OWNER", "REPO"), tt.state)
 		if (err != nil) != tt.wantErr {
 			t.Errorf("RepoMilestones() error = %v, wantErr %v", err, tt.wantErr)
 			return
@@ -341,3 +404,37 @@ func Test_RepoMilestones(t *testing.T) {
 		}
 	}
 }
+
+func Test_RepoLabels_pagination(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	for page := 1; page <= RepoMetadataListLimit/100; page++ {
+		page := page
+		reg.Register(httpmock.GraphQL(`query RepositoryLabelList\b`), func(req *http.Request) (*http.Response, error) {
+			nodes := make([]string, 100)
+			for i := range nodes {
+				nodes[i] = fmt.Sprintf(`{ "name": "label-%d-%d", "id": "ID%d_%d" }`, page, i, page, i)
+			}
+			body := fmt.Sprintf(`
+			{ "data": { "repository": { "labels": {
+				"nodes": [%s],
+				"pageInfo": { "hasNextPage": true, "endCursor": "PAGE%d" }
+			} } } }
+			`, strings.Join(nodes, ","), page)
+			return httpmock.StringResponse(body)(req)
+		})
+	}
+
+	client := NewClient(ReplaceTripper(reg))
+	labels, truncated, err := RepoLabels(client, ghrepo.New("OWNER", "REPO"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !truncated {
+		t.Error("expected truncated to be true")
+	}
+	if len(labels) != RepoMetadataListLimit {
+		t.Errorf("expected %d labels, got %d", RepoMetadataListLimit, len(labels))
+	}
+}
@@ -65,6 +65,20 @@ var prReviews = shortenQuery(`
 	}
 `)
 
+var prLatestReviews = shortenQuery(`
+	latestReviews(first: 100) {
+		nodes {
+			author{login},
+			authorAssociation,
+			submittedAt,
+			body,
+			state,
+			reactionGroups{content,users{totalCount}}
+		}
+		pageInfo{hasNextPage,endCursor}
+	}
+`)
+
 var prFiles = shortenQuery(`
 	files(first: 100) {
 		nodes {
@@ -120,7 +134,8 @@ func StatusCheckRollupGraphQL(after string) string {
 								conclusion,
 								startedAt,
 								completedAt,
-								detailsUrl
+								detailsUrl,
+								checkSuite{workflowRun{workflow{name}}}
 							}
 						},
 						pageInfo{hasNextPage,endCursor}
@@ -145,6 +160,7 @@ var IssueFields = []string{
 	"number",
 	"projectCards",
 	"reactionGroups",
+	"repository",
 	"state",
 	"title",
 	"updatedAt",
@@ -170,6 +186,7 @@ var PullRequestFields = append(IssueFields,
 	"mergedBy",
 	"mergeStateStatus",
 	"potentialMergeCommit",
+	"latestReviews",
 	"reviewDecision",
 	"reviewRequests",
 	"reviews",
@@ -198,6 +215,8 @@ func PullRequestGraphQL(fields []string) string {
 			q = append(q, `milestone{number,title,description,dueOn}`)
 		case "reactionGroups":
 			q = append(q, `reactionGroups{content,users{totalCount}}`)
+		case "repository":
+			q = append(q, `repository{name,owner{login}}`)
 		case "mergeCommit":
 			q = append(q, `mergeCommit{oid}`)
 		case "potentialMergeCommit":
@@ -208,6 +227,8 @@ func PullRequestGraphQL(fields []string) string {
 			q = append(q, prReviewRequests)
 		case "reviews":
 			q = append(q, prReviews)
+		case "latestReviews":
+			q = append(q, prLatestReviews)
 		case "files":
 			q = append(q, prFiles)
 		case "commits":
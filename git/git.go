@@ -322,11 +322,16 @@ func RunClone(cloneURL string, args []string) (target string, err error) {
 }
 
 func AddUpstreamRemote(upstreamURL, cloneDir string, branches []string) error {
+	return AddNamedRemote(upstreamURL, cloneDir, "upstream", branches)
+}
+
+// AddNamedRemote adds a git remote under the given name, fetching the specified branches.
+func AddNamedRemote(url, cloneDir, name string, branches []string) error {
 	args := []string{"-C", cloneDir, "remote", "add"}
 	for _, branch := range branches {
 		args = append(args, "-t", branch)
 	}
-	args = append(args, "-f", "upstream", upstreamURL)
+	args = append(args, "-f", name, url)
 	cloneCmd, err := GitCommand(args...)
 	if err != nil {
 		return err
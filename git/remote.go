@@ -147,3 +147,13 @@ func SetRemoteResolution(name, resolution string) error {
 	}
 	return run.PrepareCmd(addCmd).Run()
 }
+
+// SetRemoteResolutionInDir is like SetRemoteResolution but operates against a repository in dir
+// instead of the current directory.
+func SetRemoteResolutionInDir(dir, name, resolution string) error {
+	addCmd, err := GitCommand("-C", dir, "config", "--add", fmt.Sprintf("remote.%s.gh-resolved", name), resolution)
+	if err != nil {
+		return err
+	}
+	return run.PrepareCmd(addCmd).Run()
+}
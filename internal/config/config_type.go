@@ -50,6 +50,11 @@ var configOptions = []ConfigOption{
 		Description:  "the terminal pager program to send standard output to",
 		DefaultValue: "",
 	},
+	{
+		Key:          "issue_status_sections",
+		Description:  "comma-separated list of sections to show in `gh issue status` (assigned, mentioned, created)",
+		DefaultValue: "",
+	},
 }
 
 func ConfigOptions() []ConfigOption {
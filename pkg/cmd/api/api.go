@@ -34,10 +34,12 @@ type ApiOptions struct {
 	Hostname            string
 	RequestMethod       string
 	RequestMethodPassed bool
+	DeleteMethod        bool
 	RequestPath         string
 	RequestInputFile    string
 	MagicFields         []string
 	RawFields           []string
+	VariablesFile       string
 	RequestHeaders      []string
 	Previews            []string
 	ShowResponseHeaders bool
@@ -46,6 +48,8 @@ type ApiOptions struct {
 	Template            string
 	CacheTTL            time.Duration
 	FilterOutput        string
+	Verbose             bool
+	VerboseBody         bool
 
 	Config     func() (config.Config, error)
 	HttpClient func() (*http.Client, error)
@@ -97,6 +101,10 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			For GraphQL requests, all fields other than "query" and "operationName" are
 			interpreted as GraphQL variables.
 
+			Use %[1]s--variables-file%[1]s to read a JSON object of GraphQL variables from a file.
+			Pass "-" to read from standard input. Values supplied via %[1]s--raw-field%[1]s or
+			%[1]s--field%[1]s take precedence over same-named values from %[1]s--variables-file%[1]s.
+
 			Raw request body may be passed from the outside via a file specified by %[1]s--input%[1]s.
 			Pass "-" to read from standard input. In this mode, parameters specified via
 			%[1]s--field%[1]s flags are serialized into URL query parameters.
@@ -105,6 +113,12 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			there are no more pages of results. For GraphQL requests, this requires that the
 			original query accepts an %[1]s$endCursor: String%[1]s variable and that it fetches the
 			%[1]spageInfo{ hasNextPage, endCursor }%[1]s set of fields from a collection.
+
+			%[1]s--verbose%[1]s prints the request method, URL, and headers, as well as the response
+			status and headers, to stderr. The %[1]sAuthorization%[1]s header is redacted. This is
+			independent of %[1]s--jq%[1]s/%[1]s--template%[1]s, which continue to process the response body
+			printed to stdout. Pass %[1]s--verbose-body%[1]s together with %[1]s--verbose%[1]s to also print
+			the request and response bodies.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# list releases in the current repository
@@ -116,6 +130,9 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			# add parameters to a GET request
 			$ gh api -X GET search/issues -f q='repo:cli/cli is:open remote'
 
+			# delete an issue label
+			$ gh api -D repos/{owner}/{repo}/labels/bug
+
 			# set a custom HTTP header
 			$ gh api -H 'Accept: application/vnd.github.v3.raw+json' ...
 
@@ -171,6 +188,14 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 			opts.RequestPath = args[0]
 			opts.RequestMethodPassed = c.Flags().Changed("method")
 
+			if opts.DeleteMethod {
+				if opts.RequestMethodPassed && !strings.EqualFold(opts.RequestMethod, "DELETE") {
+					return &cmdutil.FlagError{Err: errors.New("the `-D` shorthand conflicts with `--method`")}
+				}
+				opts.RequestMethod = "DELETE"
+				opts.RequestMethodPassed = true
+			}
+
 			if c.Flags().Changed("hostname") {
 				if err := ghinstance.HostnameValidator(opts.Hostname); err != nil {
 					return &cmdutil.FlagError{Err: fmt.Errorf("error parsing `--hostname`: %w", err)}
@@ -198,6 +223,10 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 				return err
 			}
 
+			if opts.VerboseBody && !opts.Verbose {
+				return &cmdutil.FlagError{Err: errors.New("the `--verbose-body` flag requires `--verbose`")}
+			}
+
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -207,8 +236,10 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 
 	cmd.Flags().StringVar(&opts.Hostname, "hostname", "", "The GitHub hostname for the request (default \"github.com\")")
 	cmd.Flags().StringVarP(&opts.RequestMethod, "method", "X", "GET", "The HTTP method for the request")
+	cmd.Flags().BoolVarP(&opts.DeleteMethod, "delete", "D", false, "Shorthand for `--method DELETE`")
 	cmd.Flags().StringArrayVarP(&opts.MagicFields, "field", "F", nil, "Add a typed parameter in `key=value` format")
 	cmd.Flags().StringArrayVarP(&opts.RawFields, "raw-field", "f", nil, "Add a string parameter in `key=value` format")
+	cmd.Flags().StringVar(&opts.VariablesFile, "variables-file", "", "Read GraphQL variables from a JSON `file`")
 	cmd.Flags().StringArrayVarP(&opts.RequestHeaders, "header", "H", nil, "Add a HTTP request header in `key:value` format")
 	cmd.Flags().StringSliceVarP(&opts.Previews, "preview", "p", nil, "Opt into GitHub API previews")
 	cmd.Flags().BoolVarP(&opts.ShowResponseHeaders, "include", "i", false, "Include HTTP response headers in the output")
@@ -218,6 +249,8 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", "Format the response using a Go template")
 	cmd.Flags().StringVarP(&opts.FilterOutput, "jq", "q", "", "Query to select values from the response using jq syntax")
 	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the response, e.g. \"3600s\", \"60m\", \"1h\"")
+	cmd.Flags().BoolVar(&opts.Verbose, "verbose", false, "Print the full HTTP request and response to stderr, with the Authorization header redacted")
+	cmd.Flags().BoolVar(&opts.VerboseBody, "verbose-body", false, "Used with `--verbose`, also print request and response bodies")
 	return cmd
 }
 
@@ -294,9 +327,14 @@ func apiRun(opts *ApiOptions) error {
 		host = opts.Hostname
 	}
 
+	var verboseLog io.Writer
+	if opts.Verbose {
+		verboseLog = opts.IO.ErrOut
+	}
+
 	hasNextPage := true
 	for hasNextPage {
-		resp, err := httpRequest(httpClient, host, method, requestPath, requestBody, requestHeaders)
+		resp, err := httpRequest(httpClient, host, method, requestPath, requestBody, requestHeaders, verboseLog, opts.VerboseBody)
 		if err != nil {
 			return err
 		}
@@ -335,6 +373,9 @@ func processResponse(resp *http.Response, opts *ApiOptions, headersOutputStream
 	}
 
 	if resp.StatusCode == 204 {
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
 		return
 	}
 	var responseBody io.Reader = resp.Body
@@ -352,7 +393,8 @@ func processResponse(resp *http.Response, opts *ApiOptions, headersOutputStream
 
 	var bodyCopy *bytes.Buffer
 	isGraphQLPaginate := isJSON && resp.StatusCode == 200 && opts.Paginate && opts.RequestPath == "graphql"
-	if isGraphQLPaginate {
+	logResponseBody := opts.Verbose && opts.VerboseBody
+	if isGraphQLPaginate || logResponseBody {
 		bodyCopy = &bytes.Buffer{}
 		responseBody = io.TeeReader(responseBody, bodyCopy)
 	}
@@ -382,6 +424,10 @@ func processResponse(resp *http.Response, opts *ApiOptions, headersOutputStream
 		}
 	}
 
+	if logResponseBody {
+		fmt.Fprintf(opts.IO.ErrOut, "%s\r\n\r\n", bodyCopy.Bytes())
+	}
+
 	if serverError != "" {
 		fmt.Fprintf(opts.IO.ErrOut, "gh: %s\n", serverError)
 		err = cmdutil.SilentError
@@ -458,6 +504,15 @@ func printHeaders(w io.Writer, headers http.Header, colorize bool) {
 
 func parseFields(opts *ApiOptions) (map[string]interface{}, error) {
 	params := make(map[string]interface{})
+	if opts.VariablesFile != "" {
+		content, err := opts.IO.ReadUserFile(opts.VariablesFile)
+		if err != nil {
+			return params, fmt.Errorf("error reading variables file %q: %w", opts.VariablesFile, err)
+		}
+		if err := json.Unmarshal(content, &params); err != nil {
+			return params, fmt.Errorf("error parsing variables file %q: %w", opts.VariablesFile, err)
+		}
+	}
 	for _, f := range opts.RawFields {
 		key, value, err := parseField(f)
 		if err != nil {
@@ -508,6 +563,9 @@ func magicFieldValue(v string, opts *ApiOptions) (interface{}, error) {
 	}
 }
 
+// openUserFile returns a reader for the --input source without loading it into memory;
+// the returned size is -1 when it can't be determined up front (e.g. reading from stdin),
+// in which case the request falls back to chunked transfer encoding.
 func openUserFile(fn string, stdin io.ReadCloser) (io.ReadCloser, int64, error) {
 	if fn == "-" {
 		return stdin, -1, nil
@@ -74,6 +74,32 @@ func Test_NewCmdApi(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name: "delete shorthand",
+			cli:  "repos/octocat/Spoon-Knife -D",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "DELETE",
+				RequestMethodPassed: true,
+				RequestPath:         "repos/octocat/Spoon-Knife",
+				RequestInputFile:    "",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "delete shorthand conflicting with method",
+			cli:      "repos/octocat/Spoon-Knife -D -XPOST",
+			wantsErr: true,
+		},
 		{
 			name: "with fields",
 			cli:  "graphql -f query=QUERY -F body=@file.txt",
@@ -314,6 +340,33 @@ func Test_NewCmdApi(t *testing.T) {
 			cli:      "user --jq .foo -t '{{.foo}}'",
 			wantsErr: true,
 		},
+		{
+			name:     "--verbose-body without --verbose",
+			cli:      "user --verbose-body",
+			wantsErr: true,
+		},
+		{
+			name: "with variables file",
+			cli:  "graphql --variables-file vars.json",
+			wants: ApiOptions{
+				Hostname:            "",
+				RequestMethod:       "GET",
+				RequestMethodPassed: false,
+				RequestPath:         "graphql",
+				RequestInputFile:    "",
+				RawFields:           []string(nil),
+				MagicFields:         []string(nil),
+				RequestHeaders:      []string(nil),
+				ShowResponseHeaders: false,
+				Paginate:            false,
+				Silent:              false,
+				CacheTTL:            0,
+				Template:            "",
+				FilterOutput:        "",
+				VariablesFile:       "vars.json",
+			},
+			wantsErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -350,6 +403,7 @@ func Test_NewCmdApi(t *testing.T) {
 			assert.Equal(t, tt.wants.CacheTTL, opts.CacheTTL)
 			assert.Equal(t, tt.wants.Template, opts.Template)
 			assert.Equal(t, tt.wants.FilterOutput, opts.FilterOutput)
+			assert.Equal(t, tt.wants.VariablesFile, opts.VariablesFile)
 		})
 	}
 }
@@ -503,6 +557,40 @@ func Test_apiRun(t *testing.T) {
 			stdout: "Mona\nHubot\n",
 			stderr: ``,
 		},
+		{
+			name: "verbose redacts Authorization header",
+			options: ApiOptions{
+				Verbose:        true,
+				RequestHeaders: []string{"Authorization: token SECRET"},
+			},
+			httpResponse: &http.Response{
+				Proto:      "HTTP/1.1",
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`body`)),
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			},
+			err:    nil,
+			stdout: "body",
+			stderr: "> GET https://api.github.com/\r\nAuthorization: REDACTED\r\nContent-Type: application/json; charset=utf-8\r\n\r\n< HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\n\r\n",
+		},
+		{
+			name: "verbose-body prints request and response bodies",
+			options: ApiOptions{
+				Verbose:     true,
+				VerboseBody: true,
+			},
+			httpResponse: &http.Response{
+				Proto:      "HTTP/1.1",
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`body`)),
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+			},
+			err:    nil,
+			stdout: "body",
+			stderr: "> GET https://api.github.com/\r\nContent-Type: application/json; charset=utf-8\r\n\r\n{}\r\n\r\n< HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\n\r\nbody\r\n\r\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -826,6 +914,32 @@ func Test_parseFields(t *testing.T) {
 	assert.Equal(t, expect, params)
 }
 
+func Test_parseFields_variablesFile(t *testing.T) {
+	f, err := ioutil.TempFile(t.TempDir(), "gh-test")
+	require.NoError(t, err)
+	defer f.Close()
+	_, err = f.WriteString(`{"name": "hubot", "stars": 123}`)
+	require.NoError(t, err)
+
+	io, _, _, _ := iostreams.Test()
+	opts := ApiOptions{
+		IO:            io,
+		VariablesFile: f.Name(),
+		RawFields:     []string{"name=robot"},
+	}
+
+	params, err := parseFields(&opts)
+	if err != nil {
+		t.Fatalf("parseFields error: %v", err)
+	}
+
+	expect := map[string]interface{}{
+		"name":  "robot",
+		"stars": float64(123),
+	}
+	assert.Equal(t, expect, params)
+}
+
 func Test_magicFieldValue(t *testing.T) {
 	f, err := ioutil.TempFile(t.TempDir(), "gh-test")
 	if err != nil {
@@ -13,7 +13,7 @@ import (
 	"github.com/cli/cli/internal/ghinstance"
 )
 
-func httpRequest(client *http.Client, hostname string, method string, p string, params interface{}, headers []string) (*http.Response, error) {
+func httpRequest(client *http.Client, hostname string, method string, p string, params interface{}, headers []string, log io.Writer, logBody bool) (*http.Response, error) {
 	isGraphQL := p == "graphql"
 	var requestURL string
 	if strings.Contains(p, "://") {
@@ -26,11 +26,14 @@ func httpRequest(client *http.Client, hostname string, method string, p string,
 
 	var body io.Reader
 	var bodyIsJSON bool
+	var requestBody []byte
 
 	switch pp := params.(type) {
 	case map[string]interface{}:
 		if strings.EqualFold(method, "GET") {
 			requestURL = addQuery(requestURL, pp)
+		} else if strings.EqualFold(method, "DELETE") && len(pp) == 0 && !isGraphQL {
+			// DELETE requests don't require a body; avoid sending an empty "{}" payload
 		} else {
 			for key, value := range pp {
 				switch vv := value.(type) {
@@ -47,6 +50,7 @@ func httpRequest(client *http.Client, hostname string, method string, p string,
 			}
 			body = bytes.NewBuffer(b)
 			bodyIsJSON = true
+			requestBody = b
 		}
 	case io.Reader:
 		body = pp
@@ -81,7 +85,39 @@ func httpRequest(client *http.Client, hostname string, method string, p string,
 		req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	}
 
-	return client.Do(req)
+	resp, err := client.Do(req)
+
+	if log != nil {
+		// the request's Authorization header is only populated once the underlying
+		// RoundTripper runs, so it can only be read back from req after client.Do returns.
+		fmt.Fprintf(log, "> %s %s\r\n", req.Method, req.URL)
+		printHeaders(log, redactHeaders(req.Header), false)
+		fmt.Fprint(log, "\r\n")
+		if logBody && len(requestBody) > 0 {
+			fmt.Fprintf(log, "%s\r\n\r\n", requestBody)
+		}
+		if resp != nil {
+			fmt.Fprintf(log, "< %s %s\r\n", resp.Proto, resp.Status)
+			printHeaders(log, resp.Header, false)
+			fmt.Fprint(log, "\r\n")
+		}
+	}
+
+	return resp, err
+}
+
+// redactHeaders returns a copy of h with the value of the Authorization header
+// replaced so secrets don't end up in --verbose output.
+func redactHeaders(h http.Header) http.Header {
+	redacted := make(http.Header, len(h))
+	for name, values := range h {
+		if strings.EqualFold(name, "Authorization") {
+			redacted[name] = []string{"REDACTED"}
+		} else {
+			redacted[name] = values
+		}
+	}
+	return redacted
 }
 
 func groupGraphQLVariables(params map[string]interface{}) map[string]interface{} {
@@ -243,6 +243,44 @@ func Test_httpRequest(t *testing.T) {
 				headers: "Content-Type: application/json; charset=utf-8\r\n",
 			},
 		},
+		{
+			name: "DELETE with no params",
+			args: args{
+				client:  &httpClient,
+				host:    "github.com",
+				method:  "DELETE",
+				p:       "repos/octocat/spoon-knife/labels/bug",
+				params:  map[string]interface{}{},
+				headers: []string{},
+			},
+			wantErr: false,
+			want: expects{
+				method:  "DELETE",
+				u:       "https://api.github.com/repos/octocat/spoon-knife/labels/bug",
+				body:    "",
+				headers: "",
+			},
+		},
+		{
+			name: "DELETE with params",
+			args: args{
+				client: &httpClient,
+				host:   "github.com",
+				method: "DELETE",
+				p:      "repos/octocat/spoon-knife/labels/bug",
+				params: map[string]interface{}{
+					"a": "b",
+				},
+				headers: []string{},
+			},
+			wantErr: false,
+			want: expects{
+				method:  "DELETE",
+				u:       "https://api.github.com/repos/octocat/spoon-knife/labels/bug",
+				body:    `{"a":"b"}`,
+				headers: "Content-Type: application/json; charset=utf-8\r\n",
+			},
+		},
 		{
 			name: "POST with body and type",
 			args: args{
@@ -267,7 +305,7 @@ func Test_httpRequest(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := httpRequest(tt.args.client, tt.args.host, tt.args.method, tt.args.p, tt.args.params, tt.args.headers)
+			got, err := httpRequest(tt.args.client, tt.args.host, tt.args.method, tt.args.p, tt.args.params, tt.args.headers, nil, false)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("httpRequest() error = %v, wantErr %v", err, tt.wantErr)
 				return
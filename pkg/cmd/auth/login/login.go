@@ -56,6 +56,10 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 			Alternatively, pass in a token on standard input by using %[1]s--with-token%[1]s.
 			The minimum required scopes for the token are: "repo", "read:org".
 
+			When standard input is not a terminal and neither %[1]s--web%[1]s nor %[1]s--with-token%[1]s
+			is given, gh assumes a token is being piped in and reads it from standard input, making it
+			unnecessary to pass %[1]s--with-token%[1]s explicitly in CI and other scripted contexts.
+
 			The --scopes flag accepts a comma separated list of scopes you want your gh credentials to have. If
 			absent, this command ensures that gh has access to a minimum set of scopes.
 		`, "`"),
@@ -70,14 +74,19 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 			$ gh auth login --hostname enterprise.internal
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !opts.IO.CanPrompt() && !(tokenStdin || opts.Web) {
-				return &cmdutil.FlagError{Err: errors.New("--web or --with-token required when not running interactively")}
-			}
-
 			if tokenStdin && opts.Web {
 				return &cmdutil.FlagError{Err: errors.New("specify only one of --web or --with-token")}
 			}
 
+			if !opts.IO.CanPrompt() && !(tokenStdin || opts.Web) {
+				if opts.IO.IsStdinTTY() {
+					return &cmdutil.FlagError{Err: errors.New("--web or --with-token required when not running interactively")}
+				}
+				// stdin is piped and no mode was specified; assume a token is being
+				// piped in, which is the common pattern for CI bootstrapping
+				tokenStdin = true
+			}
+
 			if tokenStdin {
 				defer opts.IO.In.Close()
 				token, err := ioutil.ReadAll(opts.IO.In)
@@ -85,6 +94,9 @@ func NewCmdLogin(f *cmdutil.Factory, runF func(*LoginOptions) error) *cobra.Comm
 					return fmt.Errorf("failed to read token from STDIN: %w", err)
 				}
 				opts.Token = strings.TrimSpace(string(token))
+				if opts.Token == "" {
+					return &cmdutil.FlagError{Err: errors.New("empty token provided on STDIN")}
+				}
 			}
 
 			if opts.IO.CanPrompt() && opts.Token == "" && !opts.Web {
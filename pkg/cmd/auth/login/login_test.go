@@ -58,6 +58,16 @@ func Test_NewCmdLogin(t *testing.T) {
 			cli:      "",
 			wantsErr: true,
 		},
+		{
+			name:     "nontty, no flags, token piped on stdin",
+			stdinTTY: false,
+			stdin:    "xyz987\n",
+			cli:      "",
+			wants: LoginOptions{
+				Hostname: "github.com",
+				Token:    "xyz987",
+			},
+		},
 		{
 			name:  "nontty, with-token, hostname",
 			cli:   "--hostname claire.redfield --with-token",
@@ -3,6 +3,7 @@ package refresh
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
@@ -16,8 +17,9 @@ import (
 )
 
 type RefreshOptions struct {
-	IO     *iostreams.IOStreams
-	Config func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
 
 	MainExecutable string
 
@@ -30,8 +32,9 @@ type RefreshOptions struct {
 
 func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.Command {
 	opts := &RefreshOptions{
-		IO:     f.IOStreams,
-		Config: f.Config,
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
 		AuthFlow: func(cfg config.Config, io *iostreams.IOStreams, hostname string, scopes []string) error {
 			_, err := authflow.AuthFlowWithConfig(cfg, io, hostname, "", scopes)
 			return err
@@ -47,6 +50,8 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 
 			The --scopes flag accepts a comma separated list of scopes you want your gh credentials to have. If
 			absent, this command ensures that gh has access to a minimum set of scopes.
+
+			If the credentials already have the requested scopes, this command does nothing.
 		`),
 		Example: heredoc.Doc(`
 			$ gh auth refresh --scopes write:org,read:public_key
@@ -124,6 +129,20 @@ func refreshRun(opts *RefreshOptions) error {
 		return err
 	}
 
+	if len(opts.Scopes) > 0 {
+		token, _ := cfg.Get(hostname, "oauth_token")
+		if token != "" {
+			httpClient, err := opts.HttpClient()
+			if err != nil {
+				return err
+			}
+			if currentScopes, err := shared.CurrentScopes(httpClient, hostname, token); err == nil && hasScopes(currentScopes, opts.Scopes) {
+				fmt.Fprintf(opts.IO.ErrOut, "%s already has the requested scopes.\n", hostname)
+				return nil
+			}
+		}
+	}
+
 	var additionalScopes []string
 
 	credentialFlow := &shared.GitCredentialFlow{}
@@ -149,3 +168,16 @@ func refreshRun(opts *RefreshOptions) error {
 
 	return nil
 }
+
+func hasScopes(current []string, requested []string) bool {
+	have := make(map[string]bool, len(current))
+	for _, s := range current {
+		have[s] = true
+	}
+	for _, s := range requested {
+		if !have[s] {
+			return false
+		}
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package refresh
 
 import (
 	"bytes"
+	"net/http"
 	"testing"
 
 	"github.com/cli/cli/internal/config"
@@ -133,9 +134,11 @@ func Test_refreshRun(t *testing.T) {
 		opts         *RefreshOptions
 		askStubs     func(*prompt.AskStubber)
 		cfgHosts     []string
+		oauthScopes  string
 		wantErr      string
 		nontty       bool
 		wantAuthArgs authArgs
+		wantAuthSkip bool
 	}{
 		{
 			name:    "no hosts configured",
@@ -210,6 +213,17 @@ func Test_refreshRun(t *testing.T) {
 				scopes:   []string{"repo:invite", "public_key:read"},
 			},
 		},
+		{
+			name: "scopes already present",
+			cfgHosts: []string{
+				"github.com",
+			},
+			opts: &RefreshOptions{
+				Scopes: []string{"repo:invite"},
+			},
+			oauthScopes:  "repo, repo:invite, read:org",
+			wantAuthSkip: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,6 +251,21 @@ func Test_refreshRun(t *testing.T) {
 			reg.Register(
 				httpmock.GraphQL(`query UserCurrent\b`),
 				httpmock.StringResponse(`{"data":{"viewer":{"login":"cybilb"}}}`))
+			reg.Register(
+				httpmock.REST("GET", ""),
+				func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						Request:    req,
+						StatusCode: 200,
+						Body:       http.NoBody,
+						Header: map[string][]string{
+							"X-Oauth-Scopes": {tt.oauthScopes},
+						},
+					}, nil
+				})
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
 
 			mainBuf := bytes.Buffer{}
 			hostsBuf := bytes.Buffer{}
@@ -88,3 +88,41 @@ func HasMinimumScopes(httpClient httpClient, hostname, authToken string) error {
 	}
 	return nil
 }
+
+// CurrentScopes returns the OAuth scopes granted to authToken, as reported by the API via the
+// X-Oauth-Scopes response header.
+func CurrentScopes(httpClient httpClient, hostname, authToken string) ([]string, error) {
+	apiEndpoint := ghinstance.RESTPrefix(hostname)
+
+	req, err := http.NewRequest("GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "token "+authToken)
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, res.Body)
+		res.Body.Close()
+	}()
+
+	if res.StatusCode != 200 {
+		return nil, api.HandleHTTPError(res)
+	}
+
+	scopesHeader := res.Header.Get("X-Oauth-Scopes")
+	if scopesHeader == "" {
+		return nil, nil
+	}
+
+	var scopes []string
+	for _, s := range strings.Split(scopesHeader, ",") {
+		scopes = append(scopes, strings.TrimSpace(s))
+	}
+	return scopes, nil
+}
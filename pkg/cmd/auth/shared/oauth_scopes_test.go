@@ -77,3 +77,44 @@ func Test_HasMinimumScopes(t *testing.T) {
 	}
 
 }
+
+func Test_CurrentScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   []string
+	}{
+		{
+			name:   "no scopes",
+			header: "",
+			want:   nil,
+		},
+		{
+			name:   "some scopes",
+			header: "repo, read:org",
+			want:   []string{"repo", "read:org"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakehttp := &httpmock.Registry{}
+			defer fakehttp.Verify(t)
+
+			fakehttp.Register(httpmock.REST("GET", ""), func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					Request:    req,
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(&bytes.Buffer{}),
+					Header: map[string][]string{
+						"X-Oauth-Scopes": {tt.header},
+					},
+				}, nil
+			})
+
+			client := http.Client{Transport: fakehttp}
+			got, err := CurrentScopes(&client, "github.com", "ATOKEN")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
@@ -21,6 +22,7 @@ type StatusOptions struct {
 
 	Hostname  string
 	ShowToken bool
+	Unmasked  bool
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -49,7 +51,8 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Check a specific hostname's auth status")
-	cmd.Flags().BoolVarP(&opts.ShowToken, "show-token", "t", false, "Display the auth token")
+	cmd.Flags().BoolVarP(&opts.ShowToken, "show-token", "t", false, "Display the auth token, masked to its last 4 characters")
+	cmd.Flags().BoolVar(&opts.Unmasked, "unmasked", false, "Display the auth token in full, unmasked (use with caution)")
 
 	return cmd
 }
@@ -80,6 +83,10 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	if opts.Unmasked {
+		fmt.Fprintf(stderr, "%s Your token will be printed in full. Anyone able to see your terminal will be able to read it.\n\n", cs.WarningIcon())
+	}
+
 	var failed bool
 	var isHostnameFound bool
 
@@ -130,8 +137,10 @@ func statusRun(opts *StatusOptions) error {
 					cs.SuccessIcon(), hostname, cs.Bold(proto))
 			}
 			tokenDisplay := "*******************"
-			if opts.ShowToken {
+			if opts.Unmasked {
 				tokenDisplay = token
+			} else if opts.ShowToken {
+				tokenDisplay = maskToken(token)
 			}
 			addMsg("%s Token: %s", cs.SuccessIcon(), tokenDisplay)
 		}
@@ -164,3 +173,18 @@ func statusRun(opts *StatusOptions) error {
 
 	return nil
 }
+
+// maskToken returns token with everything but its prefix (if any) and last 4 characters
+// replaced by asterisks, e.g. "ghp_****...abcd".
+func maskToken(token string) string {
+	if len(token) <= 4 {
+		return strings.Repeat("*", len(token))
+	}
+
+	var prefix string
+	if i := strings.Index(token, "_"); i > 0 && i < 5 {
+		prefix = token[:i+1]
+	}
+
+	return fmt.Sprintf("%s****...%s", prefix, token[len(token)-4:])
+}
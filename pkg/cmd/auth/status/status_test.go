@@ -39,6 +39,13 @@ func Test_NewCmdStatus(t *testing.T) {
 				ShowToken: true,
 			},
 		},
+		{
+			name: "unmasked",
+			cli:  "--unmasked",
+			wants: StatusOptions{
+				Unmasked: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -66,6 +73,8 @@ func Test_NewCmdStatus(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
+			assert.Equal(t, tt.wants.ShowToken, gotOpts.ShowToken)
+			assert.Equal(t, tt.wants.Unmasked, gotOpts.Unmasked)
 		})
 	}
 }
@@ -187,7 +196,28 @@ func Test_statusRun(t *testing.T) {
 					httpmock.GraphQL(`query UserCurrent\b`),
 					httpmock.StringResponse(`{"data":{"viewer":{"login":"tess"}}}`))
 			},
-			wantErrOut: regexp.MustCompile(`(?s)Token: xyz456.*Token: abc123`),
+			wantErrOut: regexp.MustCompile(`(?s)Token: \*\*\*\*\.\.\.z456.*Token: \*\*\*\*\.\.\.c123`),
+		},
+		{
+			name: "unmasked",
+			opts: &StatusOptions{
+				Unmasked: true,
+			},
+			cfg: func(c config.Config) {
+				_ = c.Set("joel.miller", "oauth_token", "abc123")
+				_ = c.Set("github.com", "oauth_token", "xyz456")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "api/v3/"), httpmock.ScopesResponder("repo,read:org"))
+				reg.Register(httpmock.REST("GET", ""), httpmock.ScopesResponder("repo,read:org"))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"tess"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data":{"viewer":{"login":"tess"}}}`))
+			},
+			wantErrOut: regexp.MustCompile(`(?s)will be printed in full.*Token: xyz456.*Token: abc123`),
 		}, {
 			name: "missing hostname",
 			opts: &StatusOptions{
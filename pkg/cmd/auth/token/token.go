@@ -0,0 +1,71 @@
+package token
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type TokenOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (config.Config, error)
+
+	Hostname string
+}
+
+func NewCmdToken(f *cmdutil.Factory, runF func(*TokenOptions) error) *cobra.Command {
+	opts := &TokenOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "token",
+		Args:  cobra.ExactArgs(0),
+		Short: "Print the auth token gh uses for a hostname",
+		Long: heredoc.Doc(`Prints the authentication token for a GitHub host.
+
+			This command outputs the token only, making it suitable for piping into other
+			tools that expect to receive a token on standard input.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return tokenRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname of the GitHub instance authenticated with")
+
+	return cmd
+}
+
+func tokenRun(opts *TokenOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname := opts.Hostname
+	if hostname == "" {
+		hostname, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+	}
+
+	token, _ := cfg.Get(hostname, "oauth_token")
+	if token == "" {
+		return fmt.Errorf("no oauth token found for %s", hostname)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s\n", token)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package token
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants TokenOptions
+	}{
+		{
+			name: "no arguments",
+			cli:  "",
+			wants: TokenOptions{
+				Hostname: "",
+			},
+		},
+		{
+			name: "hostname",
+			cli:  "--hostname harry.mason",
+			wants: TokenOptions{
+				Hostname: "harry.mason",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *TokenOptions
+			cmd := NewCmdToken(f, func(opts *TokenOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.Flags().BoolP("help", "x", false, "")
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
+		})
+	}
+}
+
+func Test_tokenRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *TokenOptions
+		cfg     func(config.Config)
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "default host",
+			opts: &TokenOptions{},
+			cfg: func(c config.Config) {
+				_ = c.Set("github.com", "oauth_token", "xxxyyyzzz")
+			},
+			wantOut: "xxxyyyzzz\n",
+		},
+		{
+			name: "specified host",
+			opts: &TokenOptions{Hostname: "example.com"},
+			cfg: func(c config.Config) {
+				_ = c.Set("github.com", "oauth_token", "xxxyyyzzz")
+				_ = c.Set("example.com", "oauth_token", "yyyzzzaaa")
+			},
+			wantOut: "yyyzzzaaa\n",
+		},
+		{
+			name:    "no token found",
+			opts:    &TokenOptions{Hostname: "example.com"},
+			cfg:     func(c config.Config) {},
+			wantErr: "no oauth token found for example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			tt.opts.IO = io
+
+			cfg := config.NewBlankConfig()
+			if tt.cfg != nil {
+				tt.cfg(cfg)
+			}
+			tt.opts.Config = func() (config.Config, error) {
+				return cfg, nil
+			}
+
+			mainBuf := bytes.Buffer{}
+			hostsBuf := bytes.Buffer{}
+			defer config.StubWriteConfig(&mainBuf, &hostsBuf)()
+
+			err := tokenRun(tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package browse
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/git"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type browser interface {
+	Browse(string) error
+}
+
+type BrowseOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Browser    browser
+
+	SelectorArg string
+	Branch      string
+	CommitSHA   string
+	PrNumber    int
+}
+
+func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Command {
+	opts := &BrowseOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Browser:    f.Browser,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "browse [<location>]",
+		Short: "Open a repository in the browser",
+		Long: heredoc.Doc(`
+			Open the GitHub repository in the web browser.
+
+			With no argument, the repository for the current directory is opened.
+			Pass a file path to open that file, or use '--branch' to open a specific
+			branch instead of the default branch. Use '--pr' to open a pull request
+			by number instead.
+		`),
+		Example: heredoc.Doc(`
+			$ gh browse
+			#=> Open the home page of the current repository
+
+			$ gh browse --branch trunk
+			#=> Open the repository at the "trunk" branch
+
+			$ gh browse --commit
+			#=> Open the repository at the last commit
+
+			$ gh browse --commit=0a1b2c3
+			#=> Open the repository at commit "0a1b2c3"
+
+			$ gh browse --pr 123
+			#=> Open pull request #123
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--branch`, `--commit`, or `--pr`",
+				opts.Branch != "",
+				opts.CommitSHA != "",
+				opts.PrNumber != 0,
+			); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return browseRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Select another branch by passing in the branch name")
+	cmd.Flags().StringVarP(&opts.CommitSHA, "commit", "c", "", "Select another commit by passing in the commit SHA, default is the last commit")
+	cmd.Flags().Lookup("commit").NoOptDefVal = "HEAD"
+	cmd.Flags().IntVarP(&opts.PrNumber, "pr", "p", 0, "Open a pull request by `number`")
+
+	return cmd
+}
+
+func browseRun(opts *BrowseOptions) error {
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	var openURL string
+	if opts.PrNumber != 0 {
+		openURL = ghrepo.GenerateRepoURL(baseRepo, "pull/%d", opts.PrNumber)
+	} else if opts.CommitSHA != "" {
+		commitSHA := opts.CommitSHA
+		if commitSHA == "HEAD" {
+			commit, err := git.LastCommit()
+			if err != nil {
+				return fmt.Errorf("could not determine last commit: %w", err)
+			}
+			commitSHA = commit.Sha
+		} else {
+			httpClient, err := opts.HttpClient()
+			if err != nil {
+				return err
+			}
+			apiClient := api.NewClientFromHTTP(httpClient)
+			commitSHA, err = resolveCommitSHA(apiClient, baseRepo, commitSHA)
+			if err != nil {
+				return err
+			}
+		}
+		openURL = ghrepo.GenerateRepoURL(baseRepo, "commit/%s", commitSHA)
+	} else if opts.SelectorArg == "" && opts.Branch == "" {
+		openURL = ghrepo.GenerateRepoURL(baseRepo, "")
+	} else if opts.Branch != "" {
+		openURL = ghrepo.GenerateRepoURL(baseRepo, "tree/%s", opts.Branch)
+	} else {
+		openURL = ghrepo.GenerateRepoURL(baseRepo, "tree/HEAD/%s", opts.SelectorArg)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
+	}
+
+	return opts.Browser.Browse(openURL)
+}
+
+func resolveCommitSHA(client *api.Client, repo ghrepo.Interface, sha string) (string, error) {
+	var result struct {
+		SHA string `json:"sha"`
+	}
+
+	path := fmt.Sprintf("repos/%s/commits/%s", ghrepo.FullName(repo), sha)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return "", fmt.Errorf("could not find commit %q: %w", sha, err)
+	}
+
+	return result.SHA, nil
+}
@@ -0,0 +1,122 @@
+package browse
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*cmdutil.TestBrowser, error) {
+	io, _, _, _ := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	browser := &cmdutil.TestBrowser{}
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Browser: browser,
+	}
+
+	cmd := NewCmdBrowse(factory, nil)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return browser, err
+}
+
+func TestBrowse_noArguments(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	browser, err := runCommand(http, true, "")
+	assert.NoError(t, err)
+	browser.Verify(t, "https://github.com/OWNER/REPO")
+}
+
+func TestBrowse_file(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	browser, err := runCommand(http, true, "main.go")
+	assert.NoError(t, err)
+	browser.Verify(t, "https://github.com/OWNER/REPO/tree/HEAD/main.go")
+}
+
+func TestBrowse_branch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	browser, err := runCommand(http, true, "--branch trunk")
+	assert.NoError(t, err)
+	browser.Verify(t, "https://github.com/OWNER/REPO/tree/trunk")
+}
+
+func TestBrowse_commitHead(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	cs, teardown := run.Stub()
+	defer teardown(t)
+	cs.Register(`git -c log.ShowSignature=false show -s --pretty=format:%H,%s HEAD`, 0, "6f1a2405cace1633d89a79c74c65f22fe78f9659,the commit title")
+
+	browser, err := runCommand(http, true, "--commit")
+	assert.NoError(t, err)
+	browser.Verify(t, "https://github.com/OWNER/REPO/commit/6f1a2405cace1633d89a79c74c65f22fe78f9659")
+}
+
+func TestBrowse_commitSHA(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/commits/0a1b2c"),
+		httpmock.StringResponse(`{"sha": "0a1b2c3d4e5f"}`),
+	)
+
+	browser, err := runCommand(http, true, "--commit=0a1b2c")
+	assert.NoError(t, err)
+	browser.Verify(t, "https://github.com/OWNER/REPO/commit/0a1b2c3d4e5f")
+}
+
+func TestBrowse_pr(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	browser, err := runCommand(http, true, "--pr 123")
+	assert.NoError(t, err)
+	browser.Verify(t, "https://github.com/OWNER/REPO/pull/123")
+}
+
+func TestBrowse_mutuallyExclusiveFlags(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--branch trunk --commit=0a1b2c")
+	assert.Error(t, err)
+
+	_, err = runCommand(http, true, "--pr 123 --branch trunk")
+	assert.Error(t, err)
+}
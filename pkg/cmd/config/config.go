@@ -6,6 +6,7 @@ import (
 
 	"github.com/cli/cli/internal/config"
 	cmdGet "github.com/cli/cli/pkg/cmd/config/get"
+	cmdList "github.com/cli/cli/pkg/cmd/config/list"
 	cmdSet "github.com/cli/cli/pkg/cmd/config/set"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -33,6 +34,7 @@ func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdGet.NewCmdConfigGet(f, nil))
 	cmd.AddCommand(cmdSet.NewCmdConfigSet(f, nil))
+	cmd.AddCommand(cmdList.NewCmdConfigList(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,80 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO     *iostreams.IOStreams
+	Config config.Config
+
+	Hostname  string
+	ShellMode bool
+}
+
+func NewCmdConfigList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Print a list of configuration keys and values",
+		Example: heredoc.Doc(`
+			$ gh config list
+			$ gh config list --host github.com
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := f.Config()
+			if err != nil {
+				return err
+			}
+			opts.Config = config
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "host", "h", "", "Get per-host configuration")
+	cmd.Flags().BoolVar(&opts.ShellMode, "shell", false, "Output as KEY=VALUE pairs suitable for shell evaluation")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	if opts.ShellMode || !opts.IO.IsStdoutTTY() {
+		for _, co := range config.ConfigOptions() {
+			val, err := opts.Config.Get(opts.Hostname, co.Key)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.IO.Out, "%s=%s\n", co.Key, val)
+		}
+		return nil
+	}
+
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, co := range config.ConfigOptions() {
+		val, err := opts.Config.Get(opts.Hostname, co.Key)
+		if err != nil {
+			return err
+		}
+		tp.AddField(co.Key, nil, nil)
+		tp.AddField(val, nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,85 @@
+package list
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdConfigList(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		output ListOptions
+	}{
+		{
+			name:   "no arguments",
+			input:  "",
+			output: ListOptions{},
+		},
+		{
+			name:   "with host",
+			input:  "--host test.com",
+			output: ListOptions{Hostname: "test.com"},
+		},
+		{
+			name:   "with shell",
+			input:  "--shell",
+			output: ListOptions{ShellMode: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				Config: func() (config.Config, error) {
+					return config.ConfigStub{}, nil
+				},
+			}
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdConfigList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.Flags().BoolP("help", "x", false, "")
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Hostname, gotOpts.Hostname)
+			assert.Equal(t, tt.output.ShellMode, gotOpts.ShellMode)
+		})
+	}
+}
+
+func Test_listRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &ListOptions{
+		IO: io,
+		Config: config.ConfigStub{
+			"git_protocol":          "ssh",
+			"editor":                "vim",
+			"prompt":                "enabled",
+			"pager":                 "",
+			"issue_status_sections": "",
+		},
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "git_protocol=ssh\neditor=vim\nprompt=enabled\npager=\nissue_status_sections=\n", stdout.String())
+}
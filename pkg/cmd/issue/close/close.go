@@ -1,6 +1,7 @@
 package close
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -19,7 +20,9 @@ type CloseOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	SelectorArg string
+	SelectorArgs []string
+	Reason       string
+	Comment      string
 }
 
 func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Command {
@@ -29,16 +32,36 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 		Config:     f.Config,
 	}
 
+	var commentFile string
+
 	cmd := &cobra.Command{
-		Use:   "close {<number> | <url>}",
+		Use:   "close {<number> | <url>} [<number> | <url> ...]",
 		Short: "Close issue",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
-			if len(args) > 0 {
-				opts.SelectorArg = args[0]
+			opts.SelectorArgs = args
+
+			if opts.Reason != "" && opts.Reason != "completed" && opts.Reason != "not-planned" {
+				return &cmdutil.FlagError{Err: errors.New("--reason must be one of `completed` or `not-planned`")}
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--comment` or `--comment-file`",
+				opts.Comment != "",
+				commentFile != "",
+			); err != nil {
+				return err
+			}
+
+			if commentFile != "" {
+				b, err := cmdutil.ReadFile(commentFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Comment = string(b)
 			}
 
 			if runF != nil {
@@ -48,6 +71,10 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Reason, "reason", "", "Reason for closing: {completed|not-planned}")
+	cmd.Flags().StringVar(&opts.Comment, "comment", "", "Leave a comment before closing")
+	cmd.Flags().StringVar(&commentFile, "comment-file", "", "Read comment `file` before closing")
+
 	return cmd
 }
 
@@ -60,22 +87,55 @@ func closeRun(opts *CloseOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
-	if err != nil {
-		return err
+	var stateReason string
+	switch opts.Reason {
+	case "completed":
+		stateReason = "COMPLETED"
+	case "not-planned":
+		stateReason = "NOT_PLANNED"
 	}
 
-	if issue.State == "CLOSED" {
-		fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already closed\n", cs.Yellow("!"), issue.Number, issue.Title)
-		return nil
+	var failed bool
+
+	for _, selectorArg := range opts.SelectorArgs {
+		issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, selectorArg)
+		if err != nil {
+			failed = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s\n", cs.FailureIcon(), err)
+			continue
+		}
+
+		if issue.State == "CLOSED" {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already closed\n", cs.Yellow("!"), issue.Number, issue.Title)
+			continue
+		}
+
+		if opts.Comment != "" {
+			_, err := api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{Body: opts.Comment, SubjectId: issue.ID})
+			if err != nil {
+				failed = true
+				fmt.Fprintf(opts.IO.ErrOut, "%s could not create comment on issue #%d: %s\n", cs.FailureIcon(), issue.Number, err)
+				continue
+			}
+		}
+
+		err = api.IssueClose(apiClient, baseRepo, *issue, stateReason)
+		if err != nil {
+			failed = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s could not close issue #%d: %s\n", cs.FailureIcon(), issue.Number, err)
+			continue
+		}
+
+		if opts.Reason != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Closed issue #%d (%s) as %s\n", cs.SuccessIconWithColor(cs.Red), issue.Number, issue.Title, opts.Reason)
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Closed issue #%d (%s)\n", cs.SuccessIconWithColor(cs.Red), issue.Number, issue.Title)
+		}
 	}
 
-	err = api.IssueClose(apiClient, baseRepo, *issue)
-	if err != nil {
-		return err
+	if failed {
+		return cmdutil.SilentError
 	}
 
-	fmt.Fprintf(opts.IO.ErrOut, "%s Closed issue #%d (%s)\n", cs.SuccessIconWithColor(cs.Red), issue.Number, issue.Title)
-
 	return nil
 }
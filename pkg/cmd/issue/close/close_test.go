@@ -87,6 +87,49 @@ func TestIssueClose(t *testing.T) {
 	}
 }
 
+func TestIssueClose_withReason(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 13, "title": "The title of the issue"}
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueClose\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["issueId"], "THE-ID")
+				assert.Equal(t, inputs["stateReason"], "NOT_PLANNED")
+			}),
+	)
+
+	output, err := runCommand(http, true, "13 --reason not-planned")
+	if err != nil {
+		t.Fatalf("error running command `issue close`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Closed issue #13 \(The title of the issue\) as not-planned`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestIssueClose_invalidReason(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "13 --reason duplicate")
+	if err == nil || err.Error() != "--reason must be one of `completed` or `not-planned`" {
+		t.Fatalf("got error: %v", err)
+	}
+}
+
 func TestIssueClose_alreadyClosed(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -112,6 +155,90 @@ func TestIssueClose_alreadyClosed(t *testing.T) {
 	}
 }
 
+func TestIssueClose_comment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 13, "title": "The title of the issue"}
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`{"addComment": {"commentEdge": {"node": {"url": "https://github.com/OWNER/REPO/issues/13#issuecomment-1"}}}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["subjectId"], "THE-ID")
+				assert.Equal(t, inputs["body"], "not going to happen")
+			}),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueClose\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["issueId"], "THE-ID")
+			}),
+	)
+
+	output, err := runCommand(http, true, `13 --comment "not going to happen"`)
+	if err != nil {
+		t.Fatalf("error running command `issue close`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Closed issue #13 \(The title of the issue\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestIssueClose_bulk(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID-1", "number": 1, "title": "First issue"}
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueClose\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID-1"}`, func(inputs map[string]interface{}) {}),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID-2", "number": 2, "title": "Second issue", "state": "CLOSED"}
+			} } }`),
+	)
+
+	output, err := runCommand(http, true, "1 2")
+	if err != nil {
+		t.Fatalf("error running command `issue close`: %v", err)
+	}
+
+	assert.Contains(t, output.Stderr(), "Closed issue #1 (First issue)")
+	assert.Contains(t, output.Stderr(), "Issue #2 (Second issue) is already closed")
+}
+
+func TestIssueClose_mutuallyExclusiveComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `1 --comment "hi" --comment-file file.txt`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestIssueClose_issuesDisabled(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -124,8 +251,13 @@ func TestIssueClose_issuesDisabled(t *testing.T) {
 			} } }`),
 	)
 
-	_, err := runCommand(http, true, "13")
-	if err == nil || err.Error() != "the 'OWNER/REPO' repository has disabled issues" {
+	output, err := runCommand(http, true, "13")
+	if err == nil || err.Error() != "SilentError" {
 		t.Fatalf("got error: %v", err)
 	}
+
+	r := regexp.MustCompile(`the 'OWNER/REPO' repository has disabled issues`)
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
 }
@@ -27,6 +27,12 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 	cmd := &cobra.Command{
 		Use:   "comment {<number> | <url>}",
 		Short: "Create a new issue comment",
+		Long: heredoc.Doc(`
+			Create a new issue comment.
+
+			With '--edit-last', edit the last comment you made on the issue, instead of
+			adding a new one.
+		`),
 		Example: heredoc.Doc(`
 			$ gh issue comment 22 --body "I was able to reproduce this issue, lets fix it."
 		`),
@@ -55,6 +61,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file`")
 	cmd.Flags().BoolP("editor", "e", false, "Add body using editor")
 	cmd.Flags().BoolP("web", "w", false, "Add body in browser")
+	cmd.Flags().BoolVarP(&opts.EditLast, "edit-last", "", false, "Edit the last comment of the current user")
 
 	return cmd
 }
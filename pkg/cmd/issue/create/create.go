@@ -3,8 +3,12 @@ package create
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
@@ -13,6 +17,7 @@ import (
 	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
 	"github.com/cli/cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -42,6 +47,9 @@ type CreateOptions struct {
 	Labels    []string
 	Projects  []string
 	Milestone string
+	Template  string
+
+	NoDuplicateCheck bool
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -64,6 +72,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			$ gh issue create --assignee monalisa,hubot
 			$ gh issue create --assignee @me
 			$ gh issue create --project "Roadmap"
+			$ gh issue create --template "Bug Report"
 		`),
 		Args: cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -73,7 +82,17 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			titleProvided := cmd.Flags().Changed("title")
 			bodyProvided := cmd.Flags().Changed("body")
-			if bodyFile != "" {
+			bodyFileProvided := bodyFile != ""
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--body` or `--body-file`",
+				bodyProvided,
+				bodyFileProvided,
+			); err != nil {
+				return err
+			}
+
+			if bodyFileProvided {
 				b, err := cmdutil.ReadFile(bodyFile, opts.IO.In)
 				if err != nil {
 					return err
@@ -82,11 +101,26 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				bodyProvided = true
 			}
 
+			if !bodyProvided && !opts.IO.IsStdinTTY() {
+				b, err := ioutil.ReadAll(opts.IO.In)
+				if err != nil {
+					return fmt.Errorf("failed to read body from STDIN: %w", err)
+				}
+				if len(b) > 0 {
+					opts.Body = string(b)
+					bodyProvided = true
+				}
+			}
+
 			if !opts.IO.CanPrompt() && opts.RecoverFile != "" {
 				return &cmdutil.FlagError{Err: errors.New("`--recover` only supported when running interactively")}
 			}
 
-			opts.Interactive = !(titleProvided && bodyProvided)
+			if opts.Template != "" && bodyProvided {
+				return &cmdutil.FlagError{Err: errors.New("`--template` is not supported when using `--body` or `--body-file`")}
+			}
+
+			opts.Interactive = !(titleProvided && (bodyProvided || opts.Template != ""))
 
 			if opts.Interactive && !opts.IO.CanPrompt() {
 				return &cmdutil.FlagError{Err: errors.New("must provide title and body when not running interactively")}
@@ -108,6 +142,8 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the issue to projects by `name`")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Add the issue to a milestone by `name`")
 	cmd.Flags().StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
+	cmd.Flags().StringVarP(&opts.Template, "template", "T", "", "Template `name` to use as starting body text")
+	cmd.Flags().BoolVar(&opts.NoDuplicateCheck, "no-duplicate-check", false, "Don't check for similar issues before creating a new one")
 
 	return cmd
 }
@@ -212,12 +248,23 @@ func createRun(opts *CreateOptions) (err error) {
 			}
 		}
 
+		if !opts.NoDuplicateCheck {
+			err = checkForDuplicates(opts, httpClient, baseRepo, tb.Title)
+			if err != nil {
+				return
+			}
+		}
+
 		if opts.Body == "" {
 			templateContent := ""
 
 			if opts.RecoverFile == "" {
 				var template shared.Template
-				template, err = tpl.Choose()
+				if opts.Template != "" {
+					template, err = tpl.Select(opts.Template)
+				} else {
+					template, err = tpl.Choose()
+				}
 				if err != nil {
 					return
 				}
@@ -280,6 +327,16 @@ func createRun(opts *CreateOptions) (err error) {
 			err = fmt.Errorf("title can't be blank")
 			return
 		}
+
+		if tb.Body == "" && opts.Template != "" {
+			var template shared.Template
+			template, err = tpl.Select(opts.Template)
+			if err != nil {
+				return
+			}
+			tb.Body = string(template.Body())
+			templateNameForSubmit = template.NameForSubmit()
+		}
 	}
 
 	if action == prShared.PreviewAction {
@@ -308,6 +365,11 @@ func createRun(opts *CreateOptions) (err error) {
 		}
 
 		fmt.Fprintln(opts.IO.Out, newIssue.URL)
+
+		if isTerminal && len(tb.Projects) > 0 {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s Added to project(s): %s\n", cs.SuccessIcon(), strings.Join(tb.Projects, ", "))
+		}
 	} else {
 		panic("Unreachable state")
 	}
@@ -315,6 +377,108 @@ func createRun(opts *CreateOptions) (err error) {
 	return
 }
 
+// duplicateSearchTimeout bounds how long the possible-duplicate search may run before
+// giving up and letting issue creation proceed unimpeded.
+const duplicateSearchTimeout = 5 * time.Second
+
+type similarIssue struct {
+	Number int
+	Title  string
+	State  string
+	URL    string
+}
+
+// findSimilarIssues searches for open issues whose title overlaps with the given title,
+// returning up to 5 candidates. Errors and timeouts are the caller's responsibility to
+// treat as "no duplicates found" rather than a hard failure.
+func findSimilarIssues(httpClient *http.Client, repo ghrepo.Interface, title string) ([]similarIssue, error) {
+	if strings.TrimSpace(title) == "" {
+		return nil, nil
+	}
+
+	timeoutClient := &http.Client{
+		Transport: httpClient.Transport,
+		Timeout:   duplicateSearchTimeout,
+	}
+	apiClient := api.NewClientFromHTTP(timeoutClient)
+
+	query := `
+	query IssueSearchForDuplicates($query: String!) {
+		search(type: ISSUE, first: 5, query: $query) {
+			nodes {
+				... on Issue {
+					number
+					title
+					state
+					url
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"query": fmt.Sprintf("repo:%s is:issue is:open in:title %s", ghrepo.FullName(repo), title),
+	}
+
+	var resp struct {
+		Search struct {
+			Nodes []similarIssue
+		}
+	}
+
+	err := apiClient.GraphQL(repo.RepoHost(), query, variables, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Search.Nodes, nil
+}
+
+// checkForDuplicates warns the user about open issues with a similar title and lets them
+// view one in the browser, cancel, or continue creating the new issue anyway. It never
+// blocks creation on a search failure or timeout.
+func checkForDuplicates(opts *CreateOptions, httpClient *http.Client, repo ghrepo.Interface, title string) error {
+	similar, err := findSimilarIssues(httpClient, repo, title)
+	if err != nil || len(similar) == 0 {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "\n%s Found issue(s) that look similar to this one:\n\n", cs.WarningIcon())
+	for _, issue := range similar {
+		fmt.Fprintf(opts.IO.ErrOut, "  #%d  %s  %s\n", issue.Number, issue.Title, cs.Gray(strings.ToLower(issue.State)))
+	}
+	fmt.Fprintln(opts.IO.ErrOut)
+
+	for {
+		options := []string{"Continue creating this issue"}
+		for _, issue := range similar {
+			options = append(options, fmt.Sprintf("View #%d in the browser", issue.Number))
+		}
+		options = append(options, "Cancel")
+
+		var selected int
+		err = prompt.SurveyAskOne(&survey.Select{
+			Message: "What would you like to do?",
+			Options: options,
+		}, &selected)
+		if err != nil {
+			return err
+		}
+
+		switch selected {
+		case 0:
+			return nil
+		case len(options) - 1:
+			return cmdutil.CancelError
+		default:
+			if err := opts.Browser.Browse(similar[selected-1].URL); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func generatePreviewURL(apiClient *api.Client, baseRepo ghrepo.Interface, tb shared.IssueMetadataState) (string, error) {
 	openURL := ghrepo.GenerateRepoURL(baseRepo, "issues/new")
 	return prShared.WithPrAndIssueQueryParams(apiClient, baseRepo, openURL, tb)
@@ -90,6 +90,26 @@ func TestNewCmdCreate(t *testing.T) {
 				Interactive: false,
 			},
 		},
+		{
+			name:     "body and body-file exclusive",
+			tty:      false,
+			cli:      fmt.Sprintf("-t mytitle -b inline -F '%s'", tmpFile),
+			wantsErr: true,
+		},
+		{
+			name:     "body from piped stdin",
+			tty:      false,
+			stdin:    "a body piped from stdin",
+			cli:      "-t mytitle",
+			wantsErr: false,
+			wantsOpts: CreateOptions{
+				Title:       "mytitle",
+				Body:        "a body piped from stdin",
+				RecoverFile: "",
+				WebMode:     false,
+				Interactive: false,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -511,6 +531,76 @@ func TestIssueCreate_nonLegacyTemplate(t *testing.T) {
 	assert.Equal(t, "", output.BrowsedURL)
 }
 
+func TestIssueCreate_nonLegacyTemplate_nonInteractive(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPOID",
+				"hasIssuesEnabled": true
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueTemplates\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "issueTemplates": [
+				{ "name": "Bug report",
+				  "body": "Does not work :((" },
+				{ "name": "Submit a request",
+				  "body": "I have a suggestion for an enhancement" }
+			] } } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+			{ "data": { "createIssue": { "issue": {
+				"URL": "https://github.com/OWNER/REPO/issues/12"
+			} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["repositoryId"], "REPOID")
+				assert.Equal(t, inputs["title"], "hello")
+				assert.Equal(t, inputs["body"], "Does not work :((")
+			}),
+	)
+
+	output, err := runCommandWithRootDirOverridden(http, true, `-t hello --template "Bug report"`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+	assert.Equal(t, "", output.BrowsedURL)
+}
+
+func TestIssueCreate_templateNotFound(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPOID",
+				"hasIssuesEnabled": true
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueTemplates\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "issueTemplates": [
+				{ "name": "Bug report",
+				  "body": "Does not work :((" }
+			] } } }`),
+	)
+
+	_, err := runCommandWithRootDirOverridden(http, true, `-t hello --template "Feature request"`, "./fixtures/repoWithNonLegacyIssueTemplates")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `no template named "Feature request"`)
+}
+
 func TestIssueCreate_continueInBrowser(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -560,6 +650,99 @@ func TestIssueCreate_continueInBrowser(t *testing.T) {
 	assert.Equal(t, "https://github.com/OWNER/REPO/issues/new?body=body&title=hello", output.BrowsedURL)
 }
 
+func TestIssueCreate_duplicateCheck(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPOID",
+				"hasIssuesEnabled": true
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueSearchForDuplicates\b`),
+		httpmock.StringResponse(`
+		{ "data": { "search": { "nodes": [
+			{ "number": 5, "title": "hello bug", "state": "OPEN", "url": "https://github.com/OWNER/REPO/issues/5" }
+		] } } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+				{ "data": { "createIssue": { "issue": {
+					"URL": "https://github.com/OWNER/REPO/issues/12"
+				} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["title"], "hello")
+			}),
+	)
+
+	as, teardown := prompt.InitAskStubber()
+	defer teardown()
+
+	as.Stub([]*prompt.QuestionStub{
+		{Name: "Title", Value: "hello"},
+	})
+	as.StubOne(1) // "View #5 in the browser"
+	as.StubOne(0) // "Continue creating this issue"
+	as.Stub([]*prompt.QuestionStub{
+		{Name: "confirmation", Value: 0},
+	})
+
+	output, err := runCommand(http, true, `-b "cash rules everything around me"`)
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/5", output.BrowsedURL)
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+}
+
+func TestIssueCreate_duplicateCheckSkipped(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPOID",
+				"hasIssuesEnabled": true
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreate\b`),
+		httpmock.GraphQLMutation(`
+				{ "data": { "createIssue": { "issue": {
+					"URL": "https://github.com/OWNER/REPO/issues/12"
+				} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["title"], "hello")
+			}),
+	)
+
+	as, teardown := prompt.InitAskStubber()
+	defer teardown()
+
+	as.Stub([]*prompt.QuestionStub{
+		{Name: "Title", Value: "hello"},
+	})
+	as.Stub([]*prompt.QuestionStub{
+		{Name: "confirmation", Value: 0},
+	})
+
+	output, err := runCommand(http, true, `-b "cash rules everything around me" --no-duplicate-check`)
+	if err != nil {
+		t.Errorf("error running command `issue create`: %v", err)
+	}
+
+	assert.Equal(t, "", output.BrowsedURL)
+	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+}
+
 func TestIssueCreate_metadata(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -636,6 +819,7 @@ func TestIssueCreate_metadata(t *testing.T) {
 	}
 
 	assert.Equal(t, "https://github.com/OWNER/REPO/issues/12\n", output.String())
+	assert.Equal(t, "\nCreating issue in OWNER/REPO\n\n✓ Added to project(s): roadmap\n", output.Stderr())
 }
 
 func TestIssueCreate_disabledIssues(t *testing.T) {
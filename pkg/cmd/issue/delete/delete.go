@@ -12,7 +12,10 @@ import (
 	"github.com/cli/cli/pkg/prompt"
 	"github.com/spf13/cobra"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 )
 
 type DeleteOptions struct {
@@ -22,6 +25,7 @@ type DeleteOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	SelectorArg string
+	Confirmed   bool
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
@@ -50,6 +54,8 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt")
+
 	return cmd
 }
 
@@ -64,11 +70,14 @@ func deleteRun(opts *DeleteOptions) error {
 
 	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
 	if err != nil {
+		if isPR, prErr := isPullRequestNumber(apiClient, baseRepo, opts.SelectorArg); prErr == nil && isPR {
+			return fmt.Errorf("%s is a pull request, not an issue; the GitHub API does not support deleting pull requests", opts.SelectorArg)
+		}
 		return err
 	}
 
 	// When executed in an interactive shell, require confirmation. Otherwise skip confirmation.
-	if opts.IO.CanPrompt() {
+	if !opts.Confirmed && opts.IO.CanPrompt() {
 		answer := ""
 		err = prompt.SurveyAskOne(
 			&survey.Input{
@@ -88,6 +97,9 @@ func deleteRun(opts *DeleteOptions) error {
 
 	err = api.IssueDelete(apiClient, baseRepo, *issue)
 	if err != nil {
+		if strings.Contains(err.Error(), "Resource not accessible") {
+			return fmt.Errorf("deleting issue #%d failed: you must have admin permissions on %s to delete an issue", issue.Number, ghrepo.FullName(baseRepo))
+		}
 		return err
 	}
 
@@ -95,3 +107,29 @@ func deleteRun(opts *DeleteOptions) error {
 
 	return nil
 }
+
+var pullRequestURLRE = regexp.MustCompile(`^/[^/]+/[^/]+/pull/(\d+)`)
+
+// isPullRequestNumber reports whether arg identifies a pull request rather than an issue, so that
+// a clearer error can be given instead of the generic "could not resolve to an Issue" message.
+func isPullRequestNumber(apiClient *api.Client, baseRepo ghrepo.Interface, arg string) (bool, error) {
+	if baseRepo == nil {
+		return false, fmt.Errorf("no base repository to check")
+	}
+
+	var number int
+	if u, err := url.Parse(arg); err == nil && (u.Scheme == "https" || u.Scheme == "http") {
+		m := pullRequestURLRE.FindStringSubmatch(u.Path)
+		if m == nil {
+			return false, nil
+		}
+		number, _ = strconv.Atoi(m[1])
+	} else {
+		number, err = strconv.Atoi(strings.TrimPrefix(arg, "#"))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return api.PullRequestExists(apiClient, baseRepo, number)
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/internal/config"
@@ -138,6 +139,90 @@ func TestIssueDelete_doesNotExist(t *testing.T) {
 	}
 }
 
+func TestIssueDelete_skipConfirm(t *testing.T) {
+	httpRegistry := &httpmock.Registry{}
+	defer httpRegistry.Verify(t)
+
+	httpRegistry.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 13, "title": "The title of the issue"}
+			} } }`),
+	)
+	httpRegistry.Register(
+		httpmock.GraphQL(`mutation IssueDelete\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["issueId"], "THE-ID")
+			}),
+	)
+
+	output, err := runCommand(httpRegistry, true, "13 --yes")
+	if err != nil {
+		t.Fatalf("error running command `issue delete`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Deleted issue #13 \(The title of the issue\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestIssueDelete_insufficientPermission(t *testing.T) {
+	httpRegistry := &httpmock.Registry{}
+	defer httpRegistry.Verify(t)
+
+	httpRegistry.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 13, "title": "The title of the issue"}
+			} } }`),
+	)
+	httpRegistry.Register(
+		httpmock.GraphQL(`mutation IssueDelete\b`),
+		httpmock.StringResponse(`
+			{ "errors": [
+				{ "message": "Resource not accessible by integration" }
+			] }`),
+	)
+
+	_, err := runCommand(httpRegistry, true, "13 --yes")
+	if err == nil || !strings.Contains(err.Error(), "admin permissions") {
+		t.Fatalf("got error: %v", err)
+	}
+}
+
+func TestIssueDelete_isPullRequest(t *testing.T) {
+	httpRegistry := &httpmock.Registry{}
+	defer httpRegistry.Verify(t)
+
+	httpRegistry.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "errors": [
+				{ "message": "Could not resolve to an Issue with the number of 13." }
+			] }
+			`),
+	)
+	httpRegistry.Register(
+		httpmock.GraphQL(`query PullRequestExists\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"pullRequest": { "id": "THE-ID" }
+			} } }`),
+	)
+
+	_, err := runCommand(httpRegistry, true, "13")
+	if err == nil || !strings.Contains(err.Error(), "is a pull request") {
+		t.Fatalf("got error: %v", err)
+	}
+}
+
 func TestIssueDelete_issuesDisabled(t *testing.T) {
 	httpRegistry := &httpmock.Registry{}
 	defer httpRegistry.Verify(t)
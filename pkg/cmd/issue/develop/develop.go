@@ -0,0 +1,163 @@
+package develop
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/context"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/safeexec"
+	"github.com/spf13/cobra"
+)
+
+type DevelopOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Remotes    func() (context.Remotes, error)
+
+	SelectorArg string
+	Name        string
+	BaseBranch  string
+	Checkout    bool
+	List        bool
+}
+
+func NewCmdDevelop(f *cmdutil.Factory, runF func(*DevelopOptions) error) *cobra.Command {
+	opts := &DevelopOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Remotes:    f.Remotes,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "develop {<number> | <url>}",
+		Short: "Create a linked branch for an issue",
+		Long: heredoc.Doc(`
+			Create a branch linked to an issue, using GitHub's "linked branches" feature.
+
+			The branch is created from the repository's default branch unless "--base" is
+			given. Pass "--checkout" to also check out the new branch locally.
+		`),
+		Args: cmdutil.ExactArgs(1, "issue number or url is required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.SelectorArg = args[0]
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--list` or `--checkout`",
+				opts.List,
+				opts.Checkout,
+			); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return developRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Name of the branch to create")
+	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "b", "", "Branch to create the new branch from (default: the repository's default branch)")
+	cmd.Flags().BoolVarP(&opts.Checkout, "checkout", "c", false, "Check out the new branch")
+	cmd.Flags().BoolVarP(&opts.List, "list", "l", false, "List branches already linked to the issue")
+
+	return cmd
+}
+
+func developRun(opts *DevelopOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	if opts.List {
+		branches, err := api.IssueLinkedBranches(apiClient, baseRepo, *issue)
+		if err != nil {
+			return fmt.Errorf("could not list linked branches: %w", err)
+		}
+		if len(branches) == 0 {
+			fmt.Fprintln(opts.IO.ErrOut, "no linked branches found")
+			return nil
+		}
+		for _, branch := range branches {
+			fmt.Fprintln(opts.IO.Out, branch.Name)
+		}
+		return nil
+	}
+
+	baseBranch := opts.BaseBranch
+	if baseBranch == "" {
+		baseBranch, err = api.RepoDefaultBranch(apiClient, baseRepo)
+		if err != nil {
+			return fmt.Errorf("could not determine the default branch: %w", err)
+		}
+	}
+
+	branch, err := api.IssueCreateLinkedBranch(apiClient, baseRepo, *issue, baseBranch, opts.Name)
+	if err != nil {
+		return fmt.Errorf("could not create linked branch: %w", err)
+	}
+
+	fmt.Fprintln(opts.IO.Out, branch.Name)
+	fmt.Fprintln(opts.IO.Out, branch.URL)
+
+	if !opts.Checkout {
+		return nil
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	protocol, _ := cfg.Get(baseRepo.RepoHost(), "git_protocol")
+
+	remoteURLOrName := ghrepo.FormatRemoteURL(baseRepo, protocol)
+	if remotes, err := opts.Remotes(); err == nil {
+		if remote, err := remotes.FindByRepo(baseRepo.RepoOwner(), baseRepo.RepoName()); err == nil {
+			remoteURLOrName = remote.Name
+		}
+	}
+
+	cmdQueue := [][]string{
+		{"git", "fetch", remoteURLOrName, fmt.Sprintf("refs/heads/%s:refs/remotes/%s/%s", branch.Name, remoteURLOrName, branch.Name)},
+		{"git", "checkout", "-b", branch.Name, "--no-track", fmt.Sprintf("%s/%s", remoteURLOrName, branch.Name)},
+	}
+
+	return executeCmds(cmdQueue)
+}
+
+func executeCmds(cmdQueue [][]string) error {
+	for _, args := range cmdQueue {
+		exe, err := safeexec.LookPath(args[0])
+		if err != nil {
+			return err
+		}
+		cmd := exec.Command(exe, args[1:]...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := run.PrepareCmd(cmd).Run(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
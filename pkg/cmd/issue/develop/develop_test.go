@@ -0,0 +1,192 @@
+package develop
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdDevelop(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestNewCmdDevelop(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wants   DevelopOptions
+		wantErr string
+	}{
+		{
+			name: "issue number",
+			cli:  "3252",
+			wants: DevelopOptions{
+				SelectorArg: "3252",
+			},
+		},
+		{
+			name: "name and base",
+			cli:  "3252 --name feature --base main",
+			wants: DevelopOptions{
+				SelectorArg: "3252",
+				Name:        "feature",
+				BaseBranch:  "main",
+			},
+		},
+		{
+			name:    "no argument",
+			cli:     "",
+			wantErr: "issue number or url is required",
+		},
+		{
+			name: "list",
+			cli:  "3252 --list",
+			wants: DevelopOptions{
+				SelectorArg: "3252",
+				List:        true,
+			},
+		},
+		{
+			name:    "list and checkout are mutually exclusive",
+			cli:     "3252 --list --checkout",
+			wantErr: "specify only one of `--list` or `--checkout`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *DevelopOptions
+			cmd := NewCmdDevelop(f, func(opts *DevelopOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, cErr := cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.Error(t, cErr)
+				assert.Equal(t, tt.wantErr, cErr.Error())
+				return
+			}
+			assert.NoError(t, cErr)
+			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+			assert.Equal(t, tt.wants.Name, gotOpts.Name)
+			assert.Equal(t, tt.wants.BaseBranch, gotOpts.BaseBranch)
+			assert.Equal(t, tt.wants.List, gotOpts.List)
+		})
+	}
+}
+
+func Test_developRun(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 1234, "title": "The title of the issue"}
+			} } }`))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueDevelopBaseBranch\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"id": "REPO-ID",
+				"ref": { "target": { "oid": "abc123" } }
+			} } }`))
+
+	http.Register(
+		httpmock.GraphQL(`mutation IssueCreateLinkedBranch\b`),
+		httpmock.GraphQLMutation(`{"data":{"createLinkedBranch":{"linkedBranch":{"ref":{"name":"1234-the-title-of-the-issue"}}}}}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["issueId"])
+			assert.Equal(t, "REPO-ID", input["repositoryId"])
+			assert.Equal(t, "abc123", input["oid"])
+		}))
+
+	output, err := runCommand(http, "1234 --base main")
+	if err != nil {
+		t.Errorf("error running command `issue develop`: %v", err)
+	}
+	assert.Equal(t, "1234-the-title-of-the-issue\nhttps://github.com/OWNER/REPO/tree/1234-the-title-of-the-issue\n", output.String())
+}
+
+func Test_developRun_list(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 1234, "title": "The title of the issue"}
+			} } }`))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueLinkedBranches\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "issue": {
+				"linkedBranches": { "nodes": [
+					{ "ref": { "name": "1234-the-title-of-the-issue" } },
+					{ "ref": { "name": "another-branch" } }
+				] }
+			} } } }`))
+
+	output, err := runCommand(http, "1234 --list")
+	if err != nil {
+		t.Errorf("error running command `issue develop --list`: %v", err)
+	}
+	assert.Equal(t, "1234-the-title-of-the-issue\nanother-branch\n", output.String())
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
@@ -12,6 +13,7 @@ import (
 	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/set"
 	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 )
@@ -26,8 +28,10 @@ type EditOptions struct {
 	EditFieldsSurvey   func(*prShared.Editable, string) error
 	FetchOptions       func(*api.Client, ghrepo.Interface, *prShared.Editable) error
 
-	SelectorArg string
-	Interactive bool
+	SelectorArg  string
+	SelectorArgs []string
+	Interactive  bool
+	DryRun       bool
 
 	prShared.Editable
 }
@@ -45,8 +49,8 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	var bodyFile string
 
 	cmd := &cobra.Command{
-		Use:   "edit {<number> | <url>}",
-		Short: "Edit an issue",
+		Use:   "edit {<number> | <url>} [...]",
+		Short: "Edit issues",
 		Example: heredoc.Doc(`
 			$ gh issue edit 23 --title "I found a bug" --body "Nothing works"
 			$ gh issue edit 23 --add-label "bug,help wanted" --remove-label "core"
@@ -54,13 +58,15 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 			$ gh issue edit 23 --add-project "Roadmap" --remove-project v1,v2
 			$ gh issue edit 23 --milestone "Version 1"
 			$ gh issue edit 23 --body-file body.txt
+			$ gh issue edit 23 24 25 --add-label triage/accepted --dry-run
 		`),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
 			opts.SelectorArg = args[0]
+			opts.SelectorArgs = args
 
 			flags := cmd.Flags()
 
@@ -101,6 +107,13 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 				opts.Editable.Milestone.Edited = true
 			}
 
+			if len(args) > 1 {
+				if !opts.Editable.Labels.Edited || opts.Editable.Title.Edited || opts.Editable.Body.Edited ||
+					opts.Editable.Assignees.Edited || opts.Editable.Projects.Edited || opts.Editable.Milestone.Edited {
+					return &cmdutil.FlagError{Err: errors.New("when editing multiple issues, only `--add-label` and `--remove-label` are supported")}
+				}
+			}
+
 			if !opts.Editable.Dirty() {
 				opts.Interactive = true
 			}
@@ -127,6 +140,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	cmd.Flags().StringSliceVar(&opts.Editable.Projects.Add, "add-project", nil, "Add the issue to projects by `name`")
 	cmd.Flags().StringSliceVar(&opts.Editable.Projects.Remove, "remove-project", nil, "Remove the issue from projects by `name`")
 	cmd.Flags().StringVarP(&opts.Editable.Milestone.Value, "milestone", "m", "", "Edit the milestone the issue belongs to by `name`")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the planned changes without applying them; with multiple issues, the resulting labels of each are listed")
 
 	return cmd
 }
@@ -138,10 +152,17 @@ func editRun(opts *EditOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
+	if len(opts.SelectorArgs) > 1 {
+		return bulkLabelEditRun(apiClient, opts)
+	}
+
 	issue, repo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
 	if err != nil {
 		return err
 	}
+	if issue.Number == 0 {
+		return fmt.Errorf("%s is not an issue; use `gh pr edit` to edit pull requests", opts.SelectorArg)
+	}
 
 	editable := opts.Editable
 	editable.Title.Default = issue.Title
@@ -178,6 +199,11 @@ func editRun(opts *EditOptions) error {
 		}
 	}
 
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would update %s\n", issue.URL)
+		return nil
+	}
+
 	opts.IO.StartProgressIndicator()
 	err = updateIssue(apiClient, repo, issue.ID, editable)
 	opts.IO.StopProgressIndicator()
@@ -190,6 +216,83 @@ func editRun(opts *EditOptions) error {
 	return nil
 }
 
+// bulkLabelEditRun applies --add-label/--remove-label to every issue in
+// opts.SelectorArgs, resolving the label names to IDs once and reusing them
+// for each issue. Failures for an individual issue are printed and do not
+// stop the remaining issues from being processed.
+func bulkLabelEditRun(client *api.Client, opts *EditOptions) error {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	editable := opts.Editable
+	opts.IO.StartProgressIndicator()
+	err = opts.FetchOptions(client, repo, &editable)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if _, err := editable.Metadata.LabelsToIDs(editable.Labels.Add); err != nil {
+		return fmt.Errorf("could not add label: %w", err)
+	}
+	if _, err := editable.Metadata.LabelsToIDs(editable.Labels.Remove); err != nil {
+		return fmt.Errorf("could not remove label: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	hadFailure := false
+
+	for _, arg := range opts.SelectorArgs {
+		issue, _, err := shared.IssueFromArg(client, opts.BaseRepo, arg)
+		if err != nil {
+			hadFailure = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s: %s\n", cs.FailureIcon(), arg, err)
+			continue
+		}
+		if issue.Number == 0 {
+			hadFailure = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s: not an issue; use `gh pr edit` to edit pull requests\n", cs.FailureIcon(), arg)
+			continue
+		}
+
+		labels := set.NewStringSet()
+		labels.AddValues(issue.Labels.Names())
+		labels.AddValues(editable.Labels.Add)
+		labels.RemoveValues(editable.Labels.Remove)
+
+		labelIds, err := editable.Metadata.LabelsToIDs(labels.ToSlice())
+		if err != nil {
+			hadFailure = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s: %s\n", cs.FailureIcon(), issue.URL, err)
+			continue
+		}
+
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.Out, "Would update %s with labels: %s\n", issue.URL, strings.Join(labels.ToSlice(), ", "))
+			continue
+		}
+
+		err = api.IssueUpdate(client, repo, githubv4.UpdateIssueInput{
+			ID:       issue.ID,
+			LabelIDs: ghIds(&labelIds),
+		})
+		if err != nil {
+			hadFailure = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s: %s\n", cs.FailureIcon(), issue.URL, err)
+			continue
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), issue.URL)
+	}
+
+	if hadFailure {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
 func updateIssue(client *api.Client, repo ghrepo.Interface, id string, options prShared.Editable) error {
 	var err error
 	params := githubv4.UpdateIssueInput{
@@ -24,11 +24,12 @@ func TestNewCmdEdit(t *testing.T) {
 	require.NoError(t, err)
 
 	tests := []struct {
-		name     string
-		input    string
-		stdin    string
-		output   EditOptions
-		wantsErr bool
+		name         string
+		input        string
+		stdin        string
+		output       EditOptions
+		selectorArgs []string
+		wantsErr     bool
 	}{
 		{
 			name:     "no argument",
@@ -200,6 +201,31 @@ func TestNewCmdEdit(t *testing.T) {
 			},
 			wantsErr: false,
 		},
+		{
+			name:  "multiple issues with add-label",
+			input: "23 24 25 --add-label bug",
+			output: EditOptions{
+				SelectorArg: "23",
+				Editable: prShared.Editable{
+					Labels: prShared.EditableSlice{
+						Add:    []string{"bug"},
+						Edited: true,
+					},
+				},
+			},
+			selectorArgs: []string{"23", "24", "25"},
+			wantsErr:     false,
+		},
+		{
+			name:     "multiple issues without label flags",
+			input:    "23 24 25",
+			wantsErr: true,
+		},
+		{
+			name:     "multiple issues with unsupported flag",
+			input:    "23 24 25 --add-label bug --title test",
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -241,6 +267,9 @@ func TestNewCmdEdit(t *testing.T) {
 			assert.Equal(t, tt.output.SelectorArg, gotOpts.SelectorArg)
 			assert.Equal(t, tt.output.Interactive, gotOpts.Interactive)
 			assert.Equal(t, tt.output.Editable, gotOpts.Editable)
+			if tt.selectorArgs != nil {
+				assert.Equal(t, tt.selectorArgs, gotOpts.SelectorArgs)
+			}
 		})
 	}
 }
@@ -356,6 +385,221 @@ func Test_editRun(t *testing.T) {
 	}
 }
 
+func Test_editRun_pullRequest(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issue": null } } }`),
+	)
+
+	opts := &EditOptions{
+		SelectorArg: "123",
+		Interactive: false,
+		Editable: prShared.Editable{
+			Title: prShared.EditableString{
+				Value:  "new title",
+				Edited: true,
+			},
+		},
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := editRun(opts)
+	assert.EqualError(t, err, "123 is not an issue; use `gh pr edit` to edit pull requests")
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_bulkLabelEditRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [
+				{ "name": "bug", "id": "BUGID" },
+				{ "name": "docs", "id": "DOCSID" }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+				"number": 1,
+				"url": "https://github.com/OWNER/REPO/issue/1",
+				"labels": { "nodes": [{ "name": "docs", "id": "DOCSID" }] }
+			} } } }`),
+	)
+	reg.Register(
+		httpmock.GraphQL(`mutation IssueUpdate\b`),
+		httpmock.GraphQLMutation(`{ "data": { "updateIssue": { "issue": { "id": "1" } } } }`,
+			func(inputs map[string]interface{}) {
+				assert.ElementsMatch(t, []interface{}{"BUGID"}, inputs["labelIds"])
+			}),
+	)
+	reg.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issue": null } } }`),
+	)
+
+	opts := &EditOptions{
+		SelectorArgs: []string{"1", "2"},
+		Editable: prShared.Editable{
+			Labels: prShared.EditableSlice{
+				Add:    []string{"bug"},
+				Remove: []string{"docs"},
+				Edited: true,
+			},
+		},
+		FetchOptions: prShared.FetchOptions,
+		IO:           io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := editRun(opts)
+	assert.EqualError(t, err, "SilentError")
+	assert.Equal(t, "✓ https://github.com/OWNER/REPO/issue/1\n", stdout.String())
+	assert.Equal(t, "X 2: not an issue; use `gh pr edit` to edit pull requests\n", stderr.String())
+}
+
+func Test_bulkLabelEditRun_dryRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [
+				{ "name": "bug", "id": "BUGID" }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+				"number": 1,
+				"url": "https://github.com/OWNER/REPO/issue/1",
+				"labels": { "nodes": [] }
+			} } } }`),
+	)
+
+	reg.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "hasIssuesEnabled": true, "issue": {
+				"number": 2,
+				"url": "https://github.com/OWNER/REPO/issue/2",
+				"labels": { "nodes": [] }
+			} } } }`),
+	)
+
+	opts := &EditOptions{
+		SelectorArgs: []string{"1", "2"},
+		DryRun:       true,
+		Editable: prShared.Editable{
+			Labels: prShared.EditableSlice{
+				Add:    []string{"bug"},
+				Edited: true,
+			},
+		},
+		FetchOptions: prShared.FetchOptions,
+		IO:           io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := editRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "Would update https://github.com/OWNER/REPO/issue/1 with labels: bug\nWould update https://github.com/OWNER/REPO/issue/2 with labels: bug\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func Test_bulkLabelEditRun_nonexistentLabel(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [
+				{ "name": "bug", "id": "BUGID" }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	opts := &EditOptions{
+		SelectorArgs: []string{"1", "2"},
+		Editable: prShared.Editable{
+			Labels: prShared.EditableSlice{
+				Add:    []string{"does-not-exist"},
+				Edited: true,
+			},
+		},
+		FetchOptions: prShared.FetchOptions,
+		IO:           io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := editRun(opts)
+	assert.EqualError(t, err, "could not add label: 'does-not-exist' not found")
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func mockIssueGet(_ *testing.T, reg *httpmock.Registry) {
 	reg.Register(
 		httpmock.GraphQL(`query IssueByNumber\b`),
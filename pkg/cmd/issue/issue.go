@@ -6,11 +6,16 @@ import (
 	cmdComment "github.com/cli/cli/pkg/cmd/issue/comment"
 	cmdCreate "github.com/cli/cli/pkg/cmd/issue/create"
 	cmdDelete "github.com/cli/cli/pkg/cmd/issue/delete"
+	cmdDevelop "github.com/cli/cli/pkg/cmd/issue/develop"
 	cmdEdit "github.com/cli/cli/pkg/cmd/issue/edit"
 	cmdList "github.com/cli/cli/pkg/cmd/issue/list"
+	cmdPin "github.com/cli/cli/pkg/cmd/issue/pin"
 	cmdReopen "github.com/cli/cli/pkg/cmd/issue/reopen"
 	cmdStatus "github.com/cli/cli/pkg/cmd/issue/status"
+	cmdSubscribe "github.com/cli/cli/pkg/cmd/issue/subscribe"
 	cmdTransfer "github.com/cli/cli/pkg/cmd/issue/transfer"
+	cmdUnpin "github.com/cli/cli/pkg/cmd/issue/unpin"
+	cmdUnsubscribe "github.com/cli/cli/pkg/cmd/issue/unsubscribe"
 	cmdView "github.com/cli/cli/pkg/cmd/issue/view"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -48,6 +53,11 @@ func NewCmdIssue(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
 	cmd.AddCommand(cmdTransfer.NewCmdTransfer(f, nil))
+	cmd.AddCommand(cmdPin.NewCmdPin(f, nil))
+	cmd.AddCommand(cmdUnpin.NewCmdUnpin(f, nil))
+	cmd.AddCommand(cmdSubscribe.NewCmdSubscribe(f, nil))
+	cmd.AddCommand(cmdUnsubscribe.NewCmdUnsubscribe(f, nil))
+	cmd.AddCommand(cmdDevelop.NewCmdDevelop(f, nil))
 
 	return cmd
 }
@@ -11,7 +11,24 @@ import (
 	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
 )
 
-func listIssues(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
+// issueOrderByField translates the --sort flag into the IssueOrderField value
+// accepted by the GraphQL API. "reactions" has no server-side ordering outside
+// of the search index, so it is rejected here; callers must require --search
+// before reaching this function with that value.
+func issueOrderByField(sort string) (string, error) {
+	switch sort {
+	case "", "created":
+		return "CREATED_AT", nil
+	case "updated":
+		return "UPDATED_AT", nil
+	case "comments":
+		return "COMMENTS", nil
+	default:
+		return "", fmt.Errorf("unsupported sort field for non-search issue list: %s", sort)
+	}
+}
+
+func listIssues(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int, countOnly bool) (*api.IssuesAndTotalCount, error) {
 	var states []string
 	switch filters.State {
 	case "open", "":
@@ -24,12 +41,16 @@ func listIssues(client *api.Client, repo ghrepo.Interface, filters prShared.Filt
 		return nil, fmt.Errorf("invalid state: %s", filters.State)
 	}
 
-	fragments := fmt.Sprintf("fragment issue on Issue {%s}", api.PullRequestGraphQL(filters.Fields))
-	query := fragments + `
-	query IssueList($owner: String!, $repo: String!, $limit: Int, $endCursor: String, $states: [IssueState!] = OPEN, $assignee: String, $author: String, $mention: String, $milestone: String) {
-		repository(owner: $owner, name: $repo) {
-			hasIssuesEnabled
-			issues(first: $limit, after: $endCursor, orderBy: {field: CREATED_AT, direction: DESC}, states: $states, filterBy: {assignee: $assignee, createdBy: $author, mentioned: $mention, milestone: $milestone}) {
+	orderByField, err := issueOrderByField(filters.Sort)
+	if err != nil {
+		return nil, err
+	}
+	orderByDirection := "DESC"
+	if filters.Order == "asc" {
+		orderByDirection = "ASC"
+	}
+
+	issuesSelection := `
 				totalCount
 				nodes {
 					...issue
@@ -37,19 +58,33 @@ func listIssues(client *api.Client, repo ghrepo.Interface, filters prShared.Filt
 				pageInfo {
 					hasNextPage
 					endCursor
-				}
+				}`
+	fragments := fmt.Sprintf("fragment issue on Issue {%s}", api.PullRequestGraphQL(filters.Fields))
+	if countOnly {
+		issuesSelection = `totalCount`
+		fragments = ""
+	}
+
+	query := fragments + fmt.Sprintf(`
+	query IssueList($owner: String!, $repo: String!, $limit: Int, $endCursor: String, $states: [IssueState!] = OPEN, $assignee: String, $author: String, $mention: String, $milestone: String, $orderByField: IssueOrderField!, $orderByDirection: OrderDirection!) {
+		repository(owner: $owner, name: $repo) {
+			hasIssuesEnabled
+			issues(first: $limit, after: $endCursor, orderBy: {field: $orderByField, direction: $orderByDirection}, states: $states, filterBy: {assignee: $assignee, createdBy: $author, mentioned: $mention, milestone: $milestone}) {
+				%s
 			}
 		}
 	}
-	`
+	`, issuesSelection)
 
 	variables := map[string]interface{}{
-		"owner":  repo.RepoOwner(),
-		"repo":   repo.RepoName(),
-		"states": states,
+		"owner":            repo.RepoOwner(),
+		"repo":             repo.RepoName(),
+		"states":           states,
+		"orderByField":     orderByField,
+		"orderByDirection": orderByDirection,
 	}
-	if filters.Assignee != "" {
-		variables["assignee"] = filters.Assignee
+	if len(filters.Assignee) > 0 {
+		variables["assignee"] = filters.Assignee[0]
 	}
 	if filters.Author != "" {
 		variables["author"] = filters.Author
@@ -129,22 +164,33 @@ loop:
 	return &res, nil
 }
 
-func searchIssues(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
+// searchResultsLimit is the maximum number of results the GitHub search API will return
+// for a single query, regardless of how many results actually match.
+const searchResultsLimit = 1000
+
+func searchIssues(client *api.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int, countOnly bool) (*api.IssuesAndTotalCount, error) {
+	searchSelection := `
+				issueCount
+				nodes { ...issue }
+				pageInfo {
+					hasNextPage
+					endCursor
+				}`
 	fragments := fmt.Sprintf("fragment issue on Issue {%s}", api.PullRequestGraphQL(filters.Fields))
+	if countOnly {
+		searchSelection = `issueCount`
+		fragments = ""
+	}
+
 	query := fragments +
-		`query IssueSearch($repo: String!, $owner: String!, $type: SearchType!, $limit: Int, $after: String, $query: String!) {
+		fmt.Sprintf(`query IssueSearch($repo: String!, $owner: String!, $type: SearchType!, $limit: Int, $after: String, $query: String!) {
 			repository(name: $repo, owner: $owner) {
 				hasIssuesEnabled
 			}
 			search(type: $type, last: $limit, after: $after, query: $query) {
-				issueCount
-				nodes { ...issue }
-				pageInfo {
-					hasNextPage
-					endCursor
-				}
+				%s
 			}
-		}`
+		}`, searchSelection)
 
 	type response struct {
 		Repository struct {
@@ -160,6 +206,7 @@ func searchIssues(client *api.Client, repo ghrepo.Interface, filters prShared.Fi
 		}
 	}
 
+	limit = min(limit, searchResultsLimit)
 	perPage := min(limit, 100)
 	searchQuery := fmt.Sprintf("repo:%s/%s %s", repo.RepoOwner(), repo.RepoName(), prShared.SearchQueryBuild(filters))
 
@@ -172,6 +219,7 @@ func searchIssues(client *api.Client, repo ghrepo.Interface, filters prShared.Fi
 	}
 
 	ic := api.IssuesAndTotalCount{}
+	seen := map[string]bool{}
 
 loop:
 	for {
@@ -187,9 +235,24 @@ loop:
 
 		ic.TotalCount = resp.Search.IssueCount
 
+		if countOnly {
+			if ic.TotalCount > searchResultsLimit {
+				ic.SearchCapped = true
+			}
+			break loop
+		}
+
 		for _, issue := range resp.Search.Nodes {
+			if seen[issue.ID] {
+				continue
+			}
+			seen[issue.ID] = true
+
 			ic.Issues = append(ic.Issues, issue)
 			if len(ic.Issues) == limit {
+				if ic.TotalCount > searchResultsLimit {
+					ic.SearchCapped = true
+				}
 				break loop
 			}
 		}
@@ -204,6 +267,110 @@ loop:
 	return &ic, nil
 }
 
+// searchIssuesByOrg runs an issue search scoped to every repository in an organization, rather
+// than a single repository. It is used by `gh issue list --owner`.
+func searchIssuesByOrg(client *api.Client, host, org string, filters prShared.FilterOptions, limit int, countOnly bool) (*api.IssuesAndTotalCount, error) {
+	fields := filters.Fields
+	if !isIncluded("repository", fields) {
+		fields = append(append([]string{}, fields...), "repository")
+	}
+
+	searchSelection := `
+				issueCount
+				nodes { ...issue }
+				pageInfo {
+					hasNextPage
+					endCursor
+				}`
+	fragments := fmt.Sprintf("fragment issue on Issue {%s}", api.PullRequestGraphQL(fields))
+	if countOnly {
+		searchSelection = `issueCount`
+		fragments = ""
+	}
+
+	query := fragments +
+		fmt.Sprintf(`query IssueSearch($type: SearchType!, $limit: Int, $after: String, $query: String!) {
+			search(type: $type, last: $limit, after: $after, query: $query) {
+				%s
+			}
+		}`, searchSelection)
+
+	type response struct {
+		Search struct {
+			IssueCount int
+			Nodes      []api.Issue
+			PageInfo   struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+		}
+	}
+
+	limit = min(limit, searchResultsLimit)
+	perPage := min(limit, 100)
+	filters.Owner = org
+	searchQuery := prShared.SearchQueryBuild(filters)
+
+	variables := map[string]interface{}{
+		"type":  "ISSUE",
+		"limit": perPage,
+		"query": searchQuery,
+	}
+
+	ic := api.IssuesAndTotalCount{}
+	seen := map[string]bool{}
+
+loop:
+	for {
+		var resp response
+		err := client.GraphQL(host, query, variables, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		ic.TotalCount = resp.Search.IssueCount
+
+		if countOnly {
+			if ic.TotalCount > searchResultsLimit {
+				ic.SearchCapped = true
+			}
+			break loop
+		}
+
+		for _, issue := range resp.Search.Nodes {
+			if seen[issue.ID] {
+				continue
+			}
+			seen[issue.ID] = true
+
+			ic.Issues = append(ic.Issues, issue)
+			if len(ic.Issues) == limit {
+				if ic.TotalCount > searchResultsLimit {
+					ic.SearchCapped = true
+				}
+				break loop
+			}
+		}
+
+		if !resp.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = resp.Search.PageInfo.EndCursor
+		variables["perPage"] = min(perPage, limit-len(ic.Issues))
+	}
+
+	return &ic, nil
+}
+
+func isIncluded(needle string, haystack []string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
 // milestoneNodeIdToDatabaseId extracts the REST Database ID from the GraphQL Node ID
 // This conversion is necessary since the GraphQL API requires the use of the milestone's database ID
 // for querying the related issues.
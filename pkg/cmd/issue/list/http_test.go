@@ -53,7 +53,7 @@ func TestIssueList(t *testing.T) {
 		Entity: "issue",
 		State:  "open",
 	}
-	_, err := listIssues(client, repo, filters, 251)
+	_, err := listIssues(client, repo, filters, 251, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -133,7 +133,7 @@ func TestIssueList_pagination(t *testing.T) {
 	)
 
 	repo := ghrepo.New("OWNER", "REPO")
-	res, err := listIssues(client, repo, prShared.FilterOptions{}, 0)
+	res, err := listIssues(client, repo, prShared.FilterOptions{}, 0, false)
 	if err != nil {
 		t.Fatalf("IssueList() error = %v", err)
 	}
@@ -161,3 +161,150 @@ func TestIssueList_pagination(t *testing.T) {
 	assert.Equal(t, []string{"enhancement"}, getLabels(res.Issues[1]))
 	assert.Equal(t, []string{"user2"}, getAssignees(res.Issues[1]))
 }
+
+func TestIssueSearch_deduplication(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := api.NewClient(api.ReplaceTripper(http))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": {
+				"repository": { "hasIssuesEnabled": true },
+				"search": {
+					"issueCount": 2,
+					"nodes": [
+						{ "id": "ISSUE-1", "title": "issue1" },
+						{ "id": "ISSUE-2", "title": "issue2" }
+					],
+					"pageInfo": {
+						"hasNextPage": true,
+						"endCursor": "ENDCURSOR"
+					}
+				}
+			} }
+			`),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": {
+				"repository": { "hasIssuesEnabled": true },
+				"search": {
+					"issueCount": 2,
+					"nodes": [
+						{ "id": "ISSUE-2", "title": "issue2" }
+					],
+					"pageInfo": {
+						"hasNextPage": false,
+						"endCursor": "ENDCURSOR"
+					}
+				}
+			} }
+			`),
+	)
+
+	repo := ghrepo.New("OWNER", "REPO")
+	res, err := searchIssues(client, repo, prShared.FilterOptions{Search: "sort:created-asc"}, 30, false)
+	if err != nil {
+		t.Fatalf("searchIssues() error = %v", err)
+	}
+
+	assert.Equal(t, 2, len(res.Issues))
+	assert.Equal(t, "issue1", res.Issues[0].Title)
+	assert.Equal(t, "issue2", res.Issues[1].Title)
+	assert.False(t, res.SearchCapped)
+}
+
+func TestIssueSearch_searchCapped(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := api.NewClient(api.ReplaceTripper(http))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": {
+				"repository": { "hasIssuesEnabled": true },
+				"search": {
+					"issueCount": 1500,
+					"nodes": [
+						{ "id": "ISSUE-1", "title": "issue1" }
+					],
+					"pageInfo": {
+						"hasNextPage": true,
+						"endCursor": "ENDCURSOR"
+					}
+				}
+			} }
+			`),
+	)
+
+	repo := ghrepo.New("OWNER", "REPO")
+	res, err := searchIssues(client, repo, prShared.FilterOptions{Search: "foo"}, 1, false)
+	if err != nil {
+		t.Fatalf("searchIssues() error = %v", err)
+	}
+
+	assert.Equal(t, 1, len(res.Issues))
+	assert.True(t, res.SearchCapped)
+}
+
+func TestListIssues_countOnly(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := api.NewClient(api.ReplaceTripper(http))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": {
+					"totalCount": 42
+				}
+			} } }
+			`),
+	)
+
+	repo := ghrepo.New("OWNER", "REPO")
+	res, err := listIssues(client, repo, prShared.FilterOptions{}, 1, true)
+	if err != nil {
+		t.Fatalf("listIssues() error = %v", err)
+	}
+
+	assert.Equal(t, 42, res.TotalCount)
+	assert.Equal(t, 0, len(res.Issues))
+
+	bodyBytes, _ := ioutil.ReadAll(http.Requests[0].Body)
+	var reqBody struct {
+		Query string
+	}
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	assert.NotContains(t, reqBody.Query, "nodes")
+}
+
+func TestSearchIssues_countOnly(t *testing.T) {
+	http := &httpmock.Registry{}
+	client := api.NewClient(api.ReplaceTripper(http))
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": {
+				"repository": { "hasIssuesEnabled": true },
+				"search": {
+					"issueCount": 1500
+				}
+			} }
+			`),
+	)
+
+	repo := ghrepo.New("OWNER", "REPO")
+	res, err := searchIssues(client, repo, prShared.FilterOptions{Search: "foo"}, 1, true)
+	if err != nil {
+		t.Fatalf("searchIssues() error = %v", err)
+	}
+
+	assert.Equal(t, 1500, res.TotalCount)
+	assert.Equal(t, 0, len(res.Issues))
+	assert.True(t, res.SearchCapped)
+}
@@ -1,6 +1,7 @@
 package list
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghrepo"
+	authShared "github.com/cli/cli/pkg/cmd/auth/shared"
 	issueShared "github.com/cli/cli/pkg/cmd/issue/shared"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
 	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
@@ -32,8 +34,9 @@ type ListOptions struct {
 
 	WebMode  bool
 	Exporter cmdutil.Exporter
+	Count    bool
 
-	Assignee     string
+	Assignee     []string
 	Labels       []string
 	State        string
 	LimitResults int
@@ -41,6 +44,11 @@ type ListOptions struct {
 	Mention      string
 	Milestone    string
 	Search       string
+	Sort         string
+	Order        string
+	Owner        string
+	Created      string
+	Updated      string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -54,13 +62,43 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List and filter issues in this repository",
+		Long: heredoc.Doc(`
+			List and filter issues in this repository.
+
+			The search query syntax is documented here:
+			<https://docs.github.com/en/search-github/searching-on-github/searching-issues-and-pull-requests>
+
+			When "--search" is used, results are resolved using GitHub search syntax rather than
+			through the other filter flags. If the search query does not include any "is:" or
+			"state:" qualifier, the "--state" flag (which defaults to "open") is still applied;
+			specifying a state qualifier in "--search" takes precedence and the "--state" flag is
+			ignored. Search results are limited to the first 1000 matches.
+
+			By default, issues are sorted by most recently created. Use "--sort" to sort by
+			"updated", "comments", or "reactions" instead, and "--order" to reverse the direction.
+			"--sort reactions" is only available together with "--search", since reaction counts
+			cannot be sorted on server-side outside of the search index. This command always talks
+			to the GraphQL API, so "--sort"/"--order" map to GraphQL ordering rather than the REST
+			issues endpoint's "sort"/"direction" query parameters.
+
+			Use "--created" or "--updated" to filter by when issues were created or last updated.
+			Both accept an absolute date, a date range, or a relative duration ("2w", "3mo", "1y"),
+			and force results to be resolved using GitHub's search index.
+
+			Use "--owner" to list issues across every repository owned by an organization instead
+			of a single repository. This searches using GitHub's search index, so results are
+			limited to the first 1000 matches, and the listing includes a column (or JSON field)
+			identifying which repository each issue belongs to.
+		`),
 		Example: heredoc.Doc(`
 			$ gh issue list -l "bug" -l "help wanted"
 			$ gh issue list -A monalisa
 			$ gh issue list -a @me
+			$ gh issue list --mention @me
 			$ gh issue list --web
 			$ gh issue list --milestone "The big 1.0"
 			$ gh issue list --search "error no:assignee sort:created-asc"
+			$ gh issue list --created 2023-01-01..2023-01-31
 		`),
 		Args: cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -71,6 +109,39 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: fmt.Errorf("invalid limit: %v", opts.LimitResults)}
 			}
 
+			switch opts.Sort {
+			case "", "created", "updated", "comments", "reactions":
+			default:
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid sort: %s", opts.Sort)}
+			}
+
+			switch opts.Order {
+			case "", "asc", "desc":
+			default:
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid order: %s", opts.Order)}
+			}
+
+			if opts.Sort == "reactions" && opts.Search == "" {
+				return &cmdutil.FlagError{Err: errors.New("--sort reactions requires --search")}
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--count`, `--web`, or `--json`",
+				opts.Count,
+				opts.WebMode,
+				opts.Exporter != nil,
+			); err != nil {
+				return err
+			}
+
+			var err error
+			if opts.Created, err = prShared.ParseDateRangeQualifier(opts.Created); err != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--created: %w", err)}
+			}
+			if opts.Updated, err = prShared.ParseDateRangeQualifier(opts.Updated); err != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--updated: %w", err)}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -79,14 +150,20 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the browser to list the issue(s)")
-	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringSliceVarP(&opts.Assignee, "assignee", "a", nil, "Filter by assignee; pass multiple times for issues assigned to any of them, or \"none\" for unassigned issues")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by labels")
 	cmd.Flags().StringVarP(&opts.State, "state", "s", "open", "Filter by state: {open|closed|all}")
 	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of issues to fetch")
 	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
 	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
-	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone `number` or `title`")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone `number` or `title`, or \"none\" for issues with no milestone")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
+	cmd.Flags().StringVar(&opts.Sort, "sort", "", "Sort fetched issues: {created|updated|comments|reactions} (default: created)")
+	cmd.Flags().StringVar(&opts.Order, "order", "", "Order of the issues returned: {asc|desc} (default: desc)")
+	cmd.Flags().StringVar(&opts.Owner, "owner", "", "List issues across all of an organization's repositories")
+	cmd.Flags().StringVar(&opts.Created, "created", "", "Filter by created `date`, e.g. \"2021-03-01\", \"2021-03-01..2021-03-31\", or \">2w\"")
+	cmd.Flags().StringVar(&opts.Updated, "updated", "", "Filter by updated `date`, e.g. \"2021-03-01\", \"2021-03-01..2021-03-31\", or \">2w\"")
+	cmd.Flags().BoolVar(&opts.Count, "count", false, "Print the number of issues matching the filters rather than listing them")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -99,6 +176,7 @@ var defaultFields = []string{
 	"state",
 	"updatedAt",
 	"labels",
+	"isPinned",
 }
 
 func listRun(opts *ListOptions) error {
@@ -107,9 +185,26 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	baseRepo, err := opts.BaseRepo()
-	if err != nil {
-		return err
+	var baseRepo ghrepo.Interface
+	var host string
+	if opts.Owner == "" {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		host = baseRepo.RepoHost()
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+		if err := validateOrgScopes(cfg, httpClient, host); err != nil {
+			return err
+		}
 	}
 
 	issueState := strings.ToLower(opts.State)
@@ -117,6 +212,11 @@ func listRun(opts *ListOptions) error {
 		issueState = ""
 	}
 
+	fields := defaultFields
+	if opts.Owner != "" && !isIncluded("repository", fields) {
+		fields = append(append([]string{}, fields...), "repository")
+	}
+
 	filterOptions := prShared.FilterOptions{
 		Entity:    "issue",
 		State:     issueState,
@@ -126,14 +226,24 @@ func listRun(opts *ListOptions) error {
 		Mention:   opts.Mention,
 		Milestone: opts.Milestone,
 		Search:    opts.Search,
-		Fields:    defaultFields,
+		Sort:      opts.Sort,
+		Order:     opts.Order,
+		Owner:     opts.Owner,
+		Created:   opts.Created,
+		Updated:   opts.Updated,
+		Fields:    fields,
 	}
 
 	isTerminal := opts.IO.IsStdoutTTY()
 
 	if opts.WebMode {
-		issueListURL := ghrepo.GenerateRepoURL(baseRepo, "issues")
-		openURL, err := prShared.ListURLWithQuery(issueListURL, filterOptions)
+		var openURL string
+		if opts.Owner == "" {
+			issueListURL := ghrepo.GenerateRepoURL(baseRepo, "issues")
+			openURL, err = prShared.ListURLWithQuery(issueListURL, filterOptions)
+		} else {
+			openURL, err = prShared.ListURLWithQuery(fmt.Sprintf("https://%s/issues", host), filterOptions)
+		}
 		if err != nil {
 			return err
 		}
@@ -148,11 +258,31 @@ func listRun(opts *ListOptions) error {
 		filterOptions.Fields = opts.Exporter.Fields()
 	}
 
-	listResult, err := issueList(httpClient, baseRepo, filterOptions, opts.LimitResults)
+	limit := opts.LimitResults
+	if opts.Count {
+		limit = 1
+	}
+
+	var listResult *api.IssuesAndTotalCount
+	if opts.Owner == "" {
+		listResult, err = issueList(httpClient, baseRepo, filterOptions, limit, opts.Count)
+	} else {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		listResult, err = searchIssuesByOrg(apiClient, host, opts.Owner, filterOptions, limit, opts.Count)
+	}
 	if err != nil {
 		return err
 	}
 
+	if listResult.SearchCapped {
+		fmt.Fprintln(opts.IO.ErrOut, "warning: this query uses the Search API which is capped at 1000 results maximum")
+	}
+
+	if opts.Count {
+		fmt.Fprintln(opts.IO.Out, listResult.TotalCount)
+		return nil
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		return err
@@ -164,7 +294,11 @@ func listRun(opts *ListOptions) error {
 	}
 
 	if isTerminal {
-		title := prShared.ListHeader(ghrepo.FullName(baseRepo), "issue", len(listResult.Issues), listResult.TotalCount, !filterOptions.IsDefault())
+		listName := opts.Owner
+		if opts.Owner == "" {
+			listName = ghrepo.FullName(baseRepo)
+		}
+		title := prShared.ListHeader(listName, "issue", len(listResult.Issues), listResult.TotalCount, !filterOptions.IsDefault())
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
@@ -173,24 +307,31 @@ func listRun(opts *ListOptions) error {
 	return nil
 }
 
-func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.IssuesAndTotalCount, error) {
-	apiClient := api.NewClientFromHTTP(client)
+// validateOrgScopes checks that the authenticated token can see private repositories and
+// enumerate organization membership before an org-wide search is attempted, since a missing
+// scope otherwise surfaces as a confusing, partial result set rather than an error.
+func validateOrgScopes(cfg config.Config, httpClient *http.Client, host string) error {
+	token, err := cfg.Get(host, "oauth_token")
+	if err != nil || token == "" {
+		return nil
+	}
 
-	if filters.Search != "" || len(filters.Labels) > 0 {
-		if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
-			milestone, err := api.MilestoneByNumber(apiClient, repo, int32(milestoneNumber))
-			if err != nil {
-				return nil, err
-			}
-			filters.Milestone = milestone.Title
+	if err := authShared.HasMinimumScopes(httpClient, host, token); err != nil {
+		var missingScopes *authShared.MissingScopesError
+		if errors.As(err, &missingScopes) {
+			return fmt.Errorf("--owner requires %w", err)
 		}
-
-		return searchIssues(apiClient, repo, filters, limit)
 	}
 
-	var err error
+	return nil
+}
+
+func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int, countOnly bool) (*api.IssuesAndTotalCount, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
 	meReplacer := shared.NewMeReplacer(apiClient, repo.RepoHost())
-	filters.Assignee, err = meReplacer.Replace(filters.Assignee)
+	var err error
+	filters.Assignee, err = meReplacer.ReplaceSlice(filters.Assignee)
 	if err != nil {
 		return nil, err
 	}
@@ -203,5 +344,20 @@ func issueList(client *http.Client, repo ghrepo.Interface, filters prShared.Filt
 		return nil, err
 	}
 
-	return listIssues(apiClient, repo, filters, limit)
+	multipleAssignees := len(filters.Assignee) > 1
+	noAssignee := len(filters.Assignee) == 1 && strings.EqualFold(filters.Assignee[0], "none")
+
+	if filters.Search != "" || len(filters.Labels) > 0 || strings.EqualFold(filters.Milestone, "none") || multipleAssignees || noAssignee || filters.Created != "" || filters.Updated != "" {
+		if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
+			milestone, err := api.MilestoneByNumber(apiClient, repo, int32(milestoneNumber))
+			if err != nil {
+				return nil, err
+			}
+			filters.Milestone = milestone.Title
+		}
+
+		return searchIssues(apiClient, repo, filters, limit, countOnly)
+	}
+
+	return listIssues(apiClient, repo, filters, limit, countOnly)
 }
@@ -79,6 +79,27 @@ func TestIssueList_nontty(t *testing.T) {
 		`4[\t]+number fore[\t]+label[\t]+\d+`)
 }
 
+func TestIssueList_json(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.FileResponse("./fixtures/issueList.json"))
+
+	output, err := runCommand(http, false, "--json number,title")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.JSONEq(t, `[
+		{"number": 1, "title": "number won"},
+		{"number": 2, "title": "number too"},
+		{"number": 4, "title": "number fore"}
+	]`, output.String())
+}
+
 func TestIssueList_tty(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -157,6 +178,151 @@ func TestIssueList_withInvalidLimitFlag(t *testing.T) {
 	}
 }
 
+func TestIssueList_withInvalidSortFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--sort nonsense")
+
+	if err == nil || err.Error() != "invalid sort: nonsense" {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+}
+
+func TestIssueList_withInvalidOrderFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--order nonsense")
+
+	if err == nil || err.Error() != "invalid order: nonsense" {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+}
+
+func TestIssueList_withSortReactionsWithoutSearch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--sort reactions")
+
+	if err == nil || err.Error() != "--sort reactions requires --search" {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+}
+
+func TestIssueList_withSortAndOrder(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": {	"repository": {
+			"hasIssuesEnabled": true,
+			"issues": { "nodes": [] }
+		} } }`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "UPDATED_AT", params["orderByField"])
+			assert.Equal(t, "ASC", params["orderByDirection"])
+		}))
+
+	output, err := runCommand(http, true, "--sort updated --order asc")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestIssueList_withSortAndSearch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": {
+			"repository": { "hasIssuesEnabled": true },
+			"search": {
+				"issueCount": 0,
+				"nodes": []
+			}
+		} }`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "repo:OWNER/REPO is:issue is:open sort:reactions-desc broken", params["query"])
+		}))
+
+	output, err := runCommand(http, true, `--search "broken" --sort reactions`)
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestIssueList_withInvalidCreatedFlag(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--created nonsense")
+
+	if err == nil || err.Error() != `--created: invalid date "nonsense"; examples: "2021-03-01", "2021-03-01..2021-03-31", ">=2021-03-01", ">2w", "<=3mo"` {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+}
+
+func TestIssueList_withCreatedAndUpdated(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": {
+			"repository": { "hasIssuesEnabled": true },
+			"search": {
+				"issueCount": 0,
+				"nodes": []
+			}
+		} }`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "repo:OWNER/REPO is:issue is:open created:2021-03-01..2021-03-31 updated:>=2021-04-01", params["query"])
+		}))
+
+	output, err := runCommand(http, true, "--created 2021-03-01..2021-03-31 --updated 2021-04-01")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestIssueList_byOwner(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.GraphQLQuery(`
+		{ "data": {
+			"search": {
+				"issueCount": 1,
+				"nodes": [
+					{ "number": 1, "title": "number won", "repository": { "name": "REPO", "owner": { "login": "OWNER" } } }
+				]
+			}
+		} }`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, "org:cli is:issue is:open", params["query"])
+		}))
+
+	output, err := runCommand(http, false, "--owner cli")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.String(), `1[\t]+OWNER/REPO[\t]+number won`)
+}
+
 func TestIssueList_disabledIssues(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -175,6 +341,66 @@ func TestIssueList_disabledIssues(t *testing.T) {
 	}
 }
 
+func TestIssueList_searchCapped(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": {
+				"repository": { "hasIssuesEnabled": true },
+				"search": {
+					"issueCount": 1500,
+					"nodes": [
+						{ "number": 1, "title": "number won" }
+					],
+					"pageInfo": { "hasNextPage": true, "endCursor": "ENDCURSOR" }
+				}
+			} }
+			`))
+
+	output, err := runCommand(http, true, `--search "broken" --limit 1`)
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "warning: this query uses the Search API which is capped at 1000 results maximum\n", output.Stderr())
+}
+
+func TestIssueList_count(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issues": { "totalCount": 17 }
+			} } }
+			`))
+
+	output, err := runCommand(http, true, "--count")
+	if err != nil {
+		t.Errorf("error running command `issue list`: %v", err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.Equal(t, "17\n", output.String())
+}
+
+func TestIssueList_countWithWeb(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--count --web")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "specify only one of `--count`, `--web`, or `--json`", err.Error())
+}
+
 func TestIssueList_web(t *testing.T) {
 	io, _, stdout, stderr := iostreams.Test()
 	io.SetStdoutTTY(true)
@@ -198,7 +424,7 @@ func TestIssueList_web(t *testing.T) {
 		},
 		WebMode:      true,
 		State:        "all",
-		Assignee:     "peter",
+		Assignee:     []string{"peter"},
 		Author:       "john",
 		Labels:       []string{"bug", "docs"},
 		Mention:      "frank",
@@ -245,10 +471,12 @@ func Test_issueList(t *testing.T) {
 						"issues": { "nodes": [] }
 					} } }`, func(_ string, params map[string]interface{}) {
 						assert.Equal(t, map[string]interface{}{
-							"owner":  "OWNER",
-							"repo":   "REPO",
-							"limit":  float64(30),
-							"states": []interface{}{"OPEN"},
+							"owner":            "OWNER",
+							"repo":             "REPO",
+							"limit":            float64(30),
+							"states":           []interface{}{"OPEN"},
+							"orderByField":     "CREATED_AT",
+							"orderByDirection": "DESC",
 						}, params)
 					}))
 			},
@@ -280,11 +508,13 @@ func Test_issueList(t *testing.T) {
 						"issues": { "nodes": [] }
 					} } }`, func(_ string, params map[string]interface{}) {
 						assert.Equal(t, map[string]interface{}{
-							"owner":     "OWNER",
-							"repo":      "REPO",
-							"limit":     float64(30),
-							"states":    []interface{}{"OPEN"},
-							"milestone": "12345",
+							"owner":            "OWNER",
+							"repo":             "REPO",
+							"limit":            float64(30),
+							"states":           []interface{}{"OPEN"},
+							"milestone":        "12345",
+							"orderByField":     "CREATED_AT",
+							"orderByDirection": "DESC",
 						}, params)
 					}))
 			},
@@ -391,11 +621,109 @@ func Test_issueList(t *testing.T) {
 						"issues": { "nodes": [] }
 					} } }`, func(_ string, params map[string]interface{}) {
 						assert.Equal(t, map[string]interface{}{
-							"owner":     "OWNER",
-							"repo":      "REPO",
-							"limit":     float64(30),
-							"states":    []interface{}{"OPEN"},
-							"milestone": "12345",
+							"owner":            "OWNER",
+							"repo":             "REPO",
+							"limit":            float64(30),
+							"states":           []interface{}{"OPEN"},
+							"milestone":        "12345",
+							"orderByField":     "CREATED_AT",
+							"orderByDirection": "DESC",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "milestone none",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:    "issue",
+					State:     "open",
+					Milestone: "none",
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "repo:OWNER/REPO is:issue is:open no:milestone",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "assignee none",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:   "issue",
+					State:    "open",
+					Assignee: []string{"none"},
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "repo:OWNER/REPO is:issue is:open no:assignee",
+							"type":  "ISSUE",
+						}, params)
+					}))
+			},
+		},
+		{
+			name: "multiple assignees",
+			args: args{
+				limit: 30,
+				repo:  ghrepo.New("OWNER", "REPO"),
+				filters: prShared.FilterOptions{
+					Entity:   "issue",
+					State:    "open",
+					Assignee: []string{"yuki", "hubot"},
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query IssueSearch\b`),
+					httpmock.GraphQLQuery(`
+					{ "data": {
+						"repository": { "hasIssuesEnabled": true },
+						"search": {
+							"issueCount": 0,
+							"nodes": []
+						}
+					} }`, func(_ string, params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"owner": "OWNER",
+							"repo":  "REPO",
+							"limit": float64(30),
+							"query": "repo:OWNER/REPO is:issue is:open (assignee:yuki OR assignee:hubot)",
+							"type":  "ISSUE",
 						}, params)
 					}))
 			},
@@ -409,7 +737,7 @@ func Test_issueList(t *testing.T) {
 					Entity:   "issue",
 					State:    "open",
 					Author:   "@me",
-					Assignee: "@me",
+					Assignee: []string{"@me"},
 					Mention:  "@me",
 				},
 			},
@@ -425,13 +753,15 @@ func Test_issueList(t *testing.T) {
 						"issues": { "nodes": [] }
 					} } }`, func(_ string, params map[string]interface{}) {
 						assert.Equal(t, map[string]interface{}{
-							"owner":    "OWNER",
-							"repo":     "REPO",
-							"limit":    float64(30),
-							"states":   []interface{}{"OPEN"},
-							"assignee": "monalisa",
-							"author":   "monalisa",
-							"mention":  "monalisa",
+							"owner":            "OWNER",
+							"repo":             "REPO",
+							"limit":            float64(30),
+							"states":           []interface{}{"OPEN"},
+							"assignee":         "monalisa",
+							"author":           "monalisa",
+							"mention":          "monalisa",
+							"orderByField":     "CREATED_AT",
+							"orderByDirection": "DESC",
 						}, params)
 					}))
 			},
@@ -445,12 +775,15 @@ func Test_issueList(t *testing.T) {
 					Entity:   "issue",
 					State:    "open",
 					Author:   "@me",
-					Assignee: "@me",
+					Assignee: []string{"@me"},
 					Mention:  "@me",
 					Search:   "auth bug",
 				},
 			},
 			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data": {"viewer": {"login": "monalisa"} } }`))
 				reg.Register(
 					httpmock.GraphQL(`query IssueSearch\b`),
 					httpmock.GraphQLQuery(`
@@ -465,7 +798,7 @@ func Test_issueList(t *testing.T) {
 							"owner": "OWNER",
 							"repo":  "REPO",
 							"limit": float64(30),
-							"query": "repo:OWNER/REPO is:issue is:open assignee:@me author:@me mentions:@me auth bug",
+							"query": "repo:OWNER/REPO is:issue is:open assignee:monalisa author:monalisa mentions:monalisa auth bug",
 							"type":  "ISSUE",
 						}, params)
 					}))
@@ -512,7 +845,7 @@ func Test_issueList(t *testing.T) {
 				tt.httpStubs(httpreg)
 			}
 			client := &http.Client{Transport: httpreg}
-			_, err := issueList(client, tt.args.repo, tt.args.filters, tt.args.limit)
+			_, err := issueList(client, tt.args.repo, tt.args.filters, tt.args.limit, false)
 			if tt.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -0,0 +1,99 @@
+package pin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/issue/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PinOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	SelectorArg string
+}
+
+func NewCmdPin(f *cmdutil.Factory, runF func(*PinOptions) error) *cobra.Command {
+	opts := &PinOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "pin {<number> | <url>}",
+		Short: "Pin issue",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return pinRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func pinRun(opts *PinOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	err = api.IssuePin(apiClient, baseRepo, *issue)
+	if err != nil {
+		return pinError(apiClient, baseRepo, err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pinned issue #%d (%s)\n", cs.SuccessIconWithColor(cs.Green), issue.Number, issue.Title)
+
+	return nil
+}
+
+// pinError turns a failed pinIssue mutation into a message that lists the currently
+// pinned issues when the 3-pinned-issues limit has been reached, so the user can pick
+// one to unpin.
+func pinError(apiClient *api.Client, repo ghrepo.Interface, err error) error {
+	if !strings.Contains(err.Error(), "maximum") {
+		return fmt.Errorf("issue could not be pinned: %w", err)
+	}
+
+	pinned, pinnedErr := api.PinnedIssues(apiClient, repo)
+	if pinnedErr != nil || len(pinned) == 0 {
+		return fmt.Errorf("issue could not be pinned: you can only pin up to 3 issues at a time")
+	}
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "issue could not be pinned: you can only pin up to 3 issues at a time. Unpin one of the following issues before pinning another:")
+	for _, p := range pinned {
+		fmt.Fprintf(&b, "  #%d %s\n", p.Number, p.Title)
+	}
+	return errors.New(strings.TrimRight(b.String(), "\n"))
+}
@@ -19,7 +19,8 @@ type ReopenOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	SelectorArg string
+	SelectorArgs []string
+	Comment      string
 }
 
 func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Command {
@@ -29,16 +30,32 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 		Config:     f.Config,
 	}
 
+	var commentFile string
+
 	cmd := &cobra.Command{
-		Use:   "reopen {<number> | <url>}",
+		Use:   "reopen {<number> | <url>} [<number> | <url> ...]",
 		Short: "Reopen issue",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
-			if len(args) > 0 {
-				opts.SelectorArg = args[0]
+			opts.SelectorArgs = args
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--comment` or `--comment-file`",
+				opts.Comment != "",
+				commentFile != "",
+			); err != nil {
+				return err
+			}
+
+			if commentFile != "" {
+				b, err := cmdutil.ReadFile(commentFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.Comment = string(b)
 			}
 
 			if runF != nil {
@@ -48,6 +65,9 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Comment, "comment", "", "Leave a comment before reopening")
+	cmd.Flags().StringVar(&commentFile, "comment-file", "", "Read comment `file` before reopening")
+
 	return cmd
 }
 
@@ -60,22 +80,43 @@ func reopenRun(opts *ReopenOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
-	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.SelectorArg)
-	if err != nil {
-		return err
-	}
+	var failed bool
+
+	for _, selectorArg := range opts.SelectorArgs {
+		issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, selectorArg)
+		if err != nil {
+			failed = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s\n", cs.FailureIcon(), err)
+			continue
+		}
+
+		if issue.State == "OPEN" {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already open\n", cs.Yellow("!"), issue.Number, issue.Title)
+			continue
+		}
+
+		if opts.Comment != "" {
+			_, err := api.CommentCreate(apiClient, baseRepo.RepoHost(), api.CommentCreateInput{Body: opts.Comment, SubjectId: issue.ID})
+			if err != nil {
+				failed = true
+				fmt.Fprintf(opts.IO.ErrOut, "%s could not create comment on issue #%d: %s\n", cs.FailureIcon(), issue.Number, err)
+				continue
+			}
+		}
 
-	if issue.State == "OPEN" {
-		fmt.Fprintf(opts.IO.ErrOut, "%s Issue #%d (%s) is already open\n", cs.Yellow("!"), issue.Number, issue.Title)
-		return nil
-	}
+		err = api.IssueReopen(apiClient, baseRepo, *issue)
+		if err != nil {
+			failed = true
+			fmt.Fprintf(opts.IO.ErrOut, "%s could not reopen issue #%d: %s\n", cs.FailureIcon(), issue.Number, err)
+			continue
+		}
 
-	err = api.IssueReopen(apiClient, baseRepo, *issue)
-	if err != nil {
-		return err
+		fmt.Fprintf(opts.IO.ErrOut, "%s Reopened issue #%d (%s)\n", cs.SuccessIconWithColor(cs.Green), issue.Number, issue.Title)
 	}
 
-	fmt.Fprintf(opts.IO.ErrOut, "%s Reopened issue #%d (%s)\n", cs.SuccessIconWithColor(cs.Green), issue.Number, issue.Title)
+	if failed {
+		return cmdutil.SilentError
+	}
 
 	return nil
 }
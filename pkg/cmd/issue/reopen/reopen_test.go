@@ -112,6 +112,90 @@ func TestIssueReopen_alreadyOpen(t *testing.T) {
 	}
 }
 
+func TestIssueReopen_comment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 2, "state": "CLOSED", "title": "The title of the issue"}
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`{"addComment": {"commentEdge": {"node": {"url": "https://github.com/OWNER/REPO/issues/2#issuecomment-1"}}}}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["subjectId"], "THE-ID")
+				assert.Equal(t, inputs["body"], "fixed now")
+			}),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueReopen\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["issueId"], "THE-ID")
+			}),
+	)
+
+	output, err := runCommand(http, true, `2 --comment "fixed now"`)
+	if err != nil {
+		t.Fatalf("error running command `issue reopen`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Reopened issue #2 \(The title of the issue\)`)
+
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestIssueReopen_bulk(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID-1", "number": 1, "state": "CLOSED", "title": "First issue"}
+			} } }`),
+	)
+	http.Register(
+		httpmock.GraphQL(`mutation IssueReopen\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID-1"}`, func(inputs map[string]interface{}) {}),
+	)
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID-2", "number": 2, "state": "OPEN", "title": "Second issue"}
+			} } }`),
+	)
+
+	output, err := runCommand(http, true, "1 2")
+	if err != nil {
+		t.Fatalf("error running command `issue reopen`: %v", err)
+	}
+
+	assert.Contains(t, output.Stderr(), "Reopened issue #1 (First issue)")
+	assert.Contains(t, output.Stderr(), "Issue #2 (Second issue) is already open")
+}
+
+func TestIssueReopen_mutuallyExclusiveComment(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `1 --comment "hi" --comment-file file.txt`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestIssueReopen_issuesDisabled(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -124,8 +208,13 @@ func TestIssueReopen_issuesDisabled(t *testing.T) {
 			} } }`),
 	)
 
-	_, err := runCommand(http, true, "2")
-	if err == nil || err.Error() != "the 'OWNER/REPO' repository has disabled issues" {
+	output, err := runCommand(http, true, "2")
+	if err == nil || err.Error() != "SilentError" {
 		t.Fatalf("got error: %v", err)
 	}
+
+	r := regexp.MustCompile(`the 'OWNER/REPO' repository has disabled issues`)
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
 }
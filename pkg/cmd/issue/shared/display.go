@@ -32,12 +32,20 @@ func PrintIssues(io *iostreams.IOStreams, prefix string, totalCount int, issues
 		if !table.IsTTY() {
 			table.AddField(issue.State, nil, nil)
 		}
-		table.AddField(text.ReplaceExcessiveWhitespace(issue.Title), nil, nil)
+		if issue.Repository.Owner.Login != "" {
+			table.AddField(fmt.Sprintf("%s/%s", issue.Repository.Owner.Login, issue.Repository.Name), nil, cs.Gray)
+		}
+		title := text.ReplaceExcessiveWhitespace(issue.Title)
+		if issue.IsPinned && table.IsTTY() {
+			title = fmt.Sprintf("📌 %s", title)
+		}
+		table.AddField(title, nil, nil)
 		table.AddField(labels, truncateLabels, cs.Gray)
 		if table.IsTTY() {
 			table.AddField(utils.FuzzyAgo(ago), nil, cs.Gray)
 		} else {
 			table.AddField(issue.UpdatedAt.String(), nil, nil)
+			table.AddField(issue.URL, nil, nil)
 		}
 		table.EndRow()
 	}
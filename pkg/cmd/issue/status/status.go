@@ -3,6 +3,10 @@ package status
 import (
 	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
@@ -21,8 +25,17 @@ type StatusOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 
 	Exporter cmdutil.Exporter
+
+	Sections []string
+	Since    string
 }
 
+// validSections are the issue groupings that `gh issue status` knows how to render.
+// GitHub issues have no concept of review requests -- that's a pull request only
+// feature -- so "review-requested" is called out explicitly in the error message
+// below rather than silently accepted or ignored.
+var validSections = []string{"assigned", "mentioned", "created"}
+
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
 	opts := &StatusOptions{
 		IO:         f.IOStreams,
@@ -45,6 +58,9 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().StringSliceVar(&opts.Sections, "sections", nil, "Only show the given sections: assigned, mentioned, created")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only show issues updated within this long ago (e.g. \"24h\", \"7d\")")
+
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -60,6 +76,19 @@ var defaultFields = []string{
 }
 
 func statusRun(opts *StatusOptions) error {
+	sections, err := resolveSections(opts)
+	if err != nil {
+		return err
+	}
+
+	var since time.Time
+	if opts.Since != "" {
+		since, err = parseSince(opts.Since)
+		if err != nil {
+			return cmdutil.FlagError{Err: err}
+		}
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -88,6 +117,12 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	if !since.IsZero() {
+		issuePayload.Assigned = filterSince(issuePayload.Assigned, since)
+		issuePayload.Mentioned = filterSince(issuePayload.Mentioned, since)
+		issuePayload.Authored = filterSince(issuePayload.Authored, since)
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
@@ -109,30 +144,107 @@ func statusRun(opts *StatusOptions) error {
 	fmt.Fprintf(out, "Relevant issues in %s\n", ghrepo.FullName(baseRepo))
 	fmt.Fprintln(out, "")
 
-	prShared.PrintHeader(opts.IO, "Issues assigned to you")
-	if issuePayload.Assigned.TotalCount > 0 {
-		issueShared.PrintIssues(opts.IO, "  ", issuePayload.Assigned.TotalCount, issuePayload.Assigned.Issues)
-	} else {
-		message := "  There are no issues assigned to you"
-		prShared.PrintMessage(opts.IO, message)
+	wanted := map[string]bool{}
+	for _, s := range sections {
+		wanted[s] = true
 	}
-	fmt.Fprintln(out)
 
-	prShared.PrintHeader(opts.IO, "Issues mentioning you")
-	if issuePayload.Mentioned.TotalCount > 0 {
-		issueShared.PrintIssues(opts.IO, "  ", issuePayload.Mentioned.TotalCount, issuePayload.Mentioned.Issues)
-	} else {
-		prShared.PrintMessage(opts.IO, "  There are no issues mentioning you")
+	sectionDefs := []struct {
+		key   string
+		title string
+		empty string
+		data  api.IssuesAndTotalCount
+	}{
+		{"assigned", "Issues assigned to you", "  There are no issues assigned to you", issuePayload.Assigned},
+		{"mentioned", "Issues mentioning you", "  There are no issues mentioning you", issuePayload.Mentioned},
+		{"created", "Issues opened by you", "  There are no issues opened by you", issuePayload.Authored},
 	}
-	fmt.Fprintln(out)
 
-	prShared.PrintHeader(opts.IO, "Issues opened by you")
-	if issuePayload.Authored.TotalCount > 0 {
-		issueShared.PrintIssues(opts.IO, "  ", issuePayload.Authored.TotalCount, issuePayload.Authored.Issues)
-	} else {
-		prShared.PrintMessage(opts.IO, "  There are no issues opened by you")
+	for _, d := range sectionDefs {
+		if !wanted[d.key] {
+			continue
+		}
+		prShared.PrintHeader(opts.IO, d.title)
+		if d.data.TotalCount > 0 {
+			issueShared.PrintIssues(opts.IO, "  ", d.data.TotalCount, d.data.Issues)
+		} else {
+			prShared.PrintMessage(opts.IO, d.empty)
+		}
+		fmt.Fprintln(out)
 	}
-	fmt.Fprintln(out)
 
 	return nil
 }
+
+// resolveSections determines which sections of `gh issue status` to render, in
+// order of precedence: the `--sections` flag, a persisted `issue_status_sections`
+// config value, and finally all sections.
+func resolveSections(opts *StatusOptions) ([]string, error) {
+	sections := opts.Sections
+	if len(sections) == 0 && opts.Config != nil {
+		if cfg, err := opts.Config(); err == nil {
+			if val, _ := cfg.Get("", "issue_status_sections"); val != "" {
+				sections = strings.Split(val, ",")
+			}
+		}
+	}
+	if len(sections) == 0 {
+		return validSections, nil
+	}
+
+	for _, s := range sections {
+		s = strings.TrimSpace(s)
+		if s == "review-requested" {
+			return nil, fmt.Errorf("'review-requested' is not a valid section: issues have no concept of review requests; see `gh pr status` for that")
+		}
+		valid := false
+		for _, v := range validSections {
+			if s == v {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid section %q: valid sections are %s", s, strings.Join(validSections, ", "))
+		}
+	}
+	return sections, nil
+}
+
+var sinceRE = regexp.MustCompile(`^(\d+)(h|d|w)$`)
+
+// parseSince parses a duration like "24h", "7d", or "2w" into a cutoff time in the past.
+func parseSince(s string) (time.Time, error) {
+	m := sinceRE.FindStringSubmatch(s)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("invalid duration %q: expected a number followed by h, d, or w (e.g. \"24h\", \"7d\")", s)
+	}
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+	var unit time.Duration
+	switch m[2] {
+	case "h":
+		unit = time.Hour
+	case "d":
+		unit = 24 * time.Hour
+	case "w":
+		unit = 7 * 24 * time.Hour
+	}
+	return time.Now().Add(-time.Duration(n) * unit), nil
+}
+
+// filterSince drops issues older than the given cutoff from an already-fetched page
+// of results. It can only narrow what the server returned, not fetch further back.
+func filterSince(ic api.IssuesAndTotalCount, since time.Time) api.IssuesAndTotalCount {
+	filtered := make([]api.Issue, 0, len(ic.Issues))
+	for _, issue := range ic.Issues {
+		if issue.UpdatedAt.After(since) {
+			filtered = append(filtered, issue)
+		}
+	}
+	ic.Issues = filtered
+	ic.TotalCount = len(filtered)
+	return ic
+}
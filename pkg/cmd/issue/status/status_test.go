@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/internal/config"
@@ -125,6 +126,61 @@ Issues opened by you
 	}
 }
 
+func TestIssueStatus_sections(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
+	http.Register(
+		httpmock.GraphQL(`query IssueStatus\b`),
+		httpmock.FileResponse("./fixtures/issueStatus.json"))
+
+	output, err := runCommand(http, true, "--sections assigned")
+	if err != nil {
+		t.Errorf("error running command `issue status`: %v", err)
+	}
+
+	if !regexp.MustCompile(`(?m)Issues assigned to you`).MatchString(output.String()) {
+		t.Errorf("expected assigned section in output, got %q", output)
+	}
+	if regexp.MustCompile(`(?m)Issues mentioning you|Issues opened by you`).MatchString(output.String()) {
+		t.Errorf("expected only the assigned section in output, got %q", output)
+	}
+}
+
+func TestIssueStatus_sectionsInvalid(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--sections review-requested")
+	if err == nil || !strings.Contains(err.Error(), "review-requested") {
+		t.Errorf("expected an error about review-requested, got %v", err)
+	}
+}
+
+func TestIssueStatus_since(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"octocat"}}}`))
+	http.Register(
+		httpmock.GraphQL(`query IssueStatus\b`),
+		httpmock.FileResponse("./fixtures/issueStatus.json"))
+
+	output, err := runCommand(http, true, "--since 1h")
+	if err != nil {
+		t.Errorf("error running command `issue status`: %v", err)
+	}
+
+	if !regexp.MustCompile(`(?m)There are no issues assigned to you`).MatchString(output.String()) {
+		t.Errorf("expected issues outside the --since window to be filtered out, got %q", output)
+	}
+}
+
 func TestIssueStatus_disabledIssues(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -0,0 +1,25 @@
+package subscribe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func issueSubscribe(client *api.Client, repo ghrepo.Interface, issueNumber int) error {
+	payload := map[string]interface{}{
+		"subscribed": true,
+		"ignored":    false,
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/issues/%d/subscription", ghrepo.FullName(repo), issueNumber)
+	return client.REST(repo.RepoHost(), "PUT", path, bytes.NewReader(payloadBytes), nil)
+}
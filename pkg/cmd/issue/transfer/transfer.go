@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
 	"github.com/cli/cli/internal/ghinstance"
@@ -12,6 +15,7 @@ import (
 	"github.com/cli/cli/pkg/cmd/issue/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
 	"github.com/shurcooL/githubv4"
 	"github.com/shurcooL/graphql"
 	"github.com/spf13/cobra"
@@ -25,6 +29,7 @@ type TransferOptions struct {
 
 	IssueSelector    string
 	DestRepoSelector string
+	SkipConfirm      bool
 }
 
 func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobra.Command {
@@ -37,7 +42,13 @@ func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobr
 	cmd := &cobra.Command{
 		Use:   "transfer {<number> | <url>} <destination-repo>",
 		Short: "Transfer issue to another repository",
-		Args:  cmdutil.ExactArgs(2, "issue and destination repository are required"),
+		Long: heredoc.Doc(`
+			Transfer an issue to another repository, including to a repository owned by a
+			different organization, as long as your account has access to both and the
+			repositories are connected through the same network (e.g. forks of the same
+			repository, or repositories owned by the same user or organization).
+		`),
+		Args: cmdutil.ExactArgs(2, "issue and destination repository are required"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.BaseRepo = f.BaseRepo
 			opts.IssueSelector = args[0]
@@ -51,6 +62,8 @@ func NewCmdTransfer(f *cmdutil.Factory, runF func(*TransferOptions) error) *cobr
 		},
 	}
 
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
 	return cmd
 }
 
@@ -61,7 +74,7 @@ func transferRun(opts *TransferOptions) error {
 	}
 
 	apiClient := api.NewClientFromHTTP(httpClient)
-	issue, _, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.IssueSelector)
+	issue, baseRepo, err := shared.IssueFromArg(apiClient, opts.BaseRepo, opts.IssueSelector)
 	if err != nil {
 		return err
 	}
@@ -71,15 +84,43 @@ func transferRun(opts *TransferOptions) error {
 		return err
 	}
 
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Transfer issue #%d (%s) from %s to %s? Labels, milestones, and projects will not carry over.", issue.Number, issue.Title, ghrepo.FullName(baseRepo), ghrepo.FullName(destRepo)),
+			Default: true,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
 	url, err := issueTransfer(httpClient, issue.ID, destRepo)
 	if err != nil {
-		return err
+		return transferError(err, destRepo)
 	}
 
 	_, err = fmt.Fprintln(opts.IO.Out, url)
 	return err
 }
 
+// transferError turns common transferIssue mutation failures into messages that name the
+// actual constraint instead of surfacing the raw GraphQL error text.
+func transferError(err error, destRepo ghrepo.Interface) error {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "network"):
+		return fmt.Errorf("issue could not be transferred: the source and destination repositories must be part of the same network (e.g. forks of the same repository, or repositories owned by the same user or organization)")
+	case strings.Contains(msg, "disabled"):
+		return fmt.Errorf("issue could not be transferred: issues are disabled for %s", ghrepo.FullName(destRepo))
+	default:
+		return fmt.Errorf("issue could not be transferred: %w", err)
+	}
+}
+
 func issueTransfer(httpClient *http.Client, issueID string, destRepo ghrepo.Interface) (string, error) {
 	var destinationRepoID string
 	if r, ok := destRepo.(*api.Repository); ok {
@@ -11,12 +11,17 @@ import (
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/httpmock"
 	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
 	"github.com/cli/cli/test"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
 )
 
 func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	return runCommandWithTTY(rt, cli, false)
+}
+
+func runCommandWithTTY(rt http.RoundTripper, cli string, tty bool) (*test.CmdOut, error) {
 	io, _, stdout, stderr := iostreams.Test()
 
 	factory := &cmdutil.Factory{
@@ -33,6 +38,7 @@ func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
 	}
 
 	io.SetStdoutTTY(true)
+	io.SetStdinTTY(tty)
 
 	cmd := NewCmdTransfer(factory, nil)
 
@@ -145,3 +151,92 @@ func Test_transferRunSuccessfulIssueTransfer(t *testing.T) {
 	}
 	assert.Equal(t, "https://github.com/OWNER1/REPO1/issues/1\n", output.String())
 }
+
+func Test_transferRunSkipConfirm(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 1234, "title": "The title of the issue"}
+			} } }`))
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+						"id": "dest-id",
+						"name": "REPO1",
+						"owner": { "login": "OWNER1" },
+						"viewerPermission": "WRITE",
+						"hasIssuesEnabled": true
+				}}}`))
+
+	http.Register(
+		httpmock.GraphQL(`mutation IssueTransfer\b`),
+		httpmock.GraphQLMutation(`{"data":{"transferIssue":{"issue":{"url":"https://github.com/OWNER1/REPO1/issues/1"}}}}`, func(input map[string]interface{}) {}))
+
+	output, err := runCommandWithTTY(http, "1234 OWNER1/REPO1 --yes", true)
+	if err != nil {
+		t.Errorf("error running command `issue transfer`: %v", err)
+	}
+	assert.Equal(t, "https://github.com/OWNER1/REPO1/issues/1\n", output.String())
+}
+
+func Test_transferRunPromptDeclined(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 1234, "title": "The title of the issue"}
+			} } }`))
+
+	as, teardown := prompt.InitAskStubber()
+	defer teardown()
+	as.StubOne(false)
+
+	output, err := runCommandWithTTY(http, "1234 OWNER1/REPO1", true)
+	assert.Error(t, err)
+	assert.True(t, cmdutil.IsUserCancellation(err))
+	assert.Equal(t, "", output.String())
+}
+
+func Test_transferRunNetworkError(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query IssueByNumber\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": {
+				"hasIssuesEnabled": true,
+				"issue": { "id": "THE-ID", "number": 1234, "title": "The title of the issue"}
+			} } }`))
+
+	http.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+						"id": "dest-id",
+						"name": "REPO1",
+						"owner": { "login": "OWNER1" },
+						"viewerPermission": "WRITE",
+						"hasIssuesEnabled": true
+				}}}`))
+
+	http.Register(
+		httpmock.GraphQL(`mutation IssueTransfer\b`),
+		httpmock.StringResponse(`{"errors":[{"message":"Only issues in repositories that are part of the same network can be transferred"}]}`))
+
+	output, err := runCommand(http, "1234 OWNER1/REPO1 --yes")
+	assert.Error(t, err)
+	assert.Equal(t, "issue could not be transferred: the source and destination repositories must be part of the same network (e.g. forks of the same repository, or repositories owned by the same user or organization)", err.Error())
+	assert.Equal(t, "", output.String())
+}
@@ -0,0 +1,13 @@
+package unsubscribe
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func issueUnsubscribe(client *api.Client, repo ghrepo.Interface, issueNumber int) error {
+	path := fmt.Sprintf("repos/%s/issues/%d/subscription", ghrepo.FullName(repo), issueNumber)
+	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
@@ -48,3 +48,27 @@ func preloadIssueComments(client *http.Client, repo ghrepo.Interface, issue *api
 	issue.Comments.PageInfo.HasNextPage = false
 	return nil
 }
+
+func preloadLinkedPullRequests(client *http.Client, repo ghrepo.Interface, issue *api.Issue) error {
+	type response struct {
+		Node struct {
+			Issue struct {
+				ClosedByPullRequestsReferences api.LinkedPullRequests `graphql:"closedByPullRequestsReferences(first: 10, includeClosedPrs: true)"`
+			} `graphql:"...on Issue"`
+		} `graphql:"node(id: $id)"`
+	}
+
+	variables := map[string]interface{}{
+		"id": githubv4.ID(issue.ID),
+	}
+
+	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(repo.RepoHost()), client)
+	var query response
+	err := gql.QueryNamed(context.Background(), "LinkedPullRequestsForIssue", &query, variables)
+	if err != nil {
+		return err
+	}
+
+	issue.LinkedPullRequests = query.Node.Issue.ClosedByPullRequestsReferences
+	return nil
+}
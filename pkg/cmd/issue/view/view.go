@@ -31,10 +31,12 @@ type ViewOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser
 
-	SelectorArg string
-	WebMode     bool
-	Comments    bool
-	Exporter    cmdutil.Exporter
+	SelectorArg   string
+	WebMode       bool
+	Comments      bool
+	CommentsSince string
+	CommentsLast  int
+	Exporter      cmdutil.Exporter
 
 	Now func() time.Time
 }
@@ -64,6 +66,12 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.CommentsSince != "" {
+				if _, err := parseCommentsSince(opts.CommentsSince); err != nil {
+					return &cmdutil.FlagError{Err: fmt.Errorf("invalid value for --comments-since: %w", err)}
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -73,7 +81,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open an issue in the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View issue comments")
-	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
+	cmd.Flags().StringVar(&opts.CommentsSince, "comments-since", "", "Only show comments created on or after `date` (ISO 8601)")
+	cmd.Flags().IntVar(&opts.CommentsLast, "last", 0, "Only show the last `N` comments")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, append(api.IssueFields, "stateReason", "linkedPullRequests"))
 
 	return cmd
 }
@@ -84,20 +94,34 @@ func viewRun(opts *ViewOptions) error {
 		return err
 	}
 
-	loadComments := opts.Comments
-	if !loadComments && opts.Exporter != nil {
+	loadComments := opts.Comments || opts.CommentsSince != "" || opts.CommentsLast > 0
+	loadLinkedPRs := opts.IO.IsStdoutTTY()
+	if opts.Exporter != nil {
 		fields := set.NewStringSet()
 		fields.AddValues(opts.Exporter.Fields())
-		loadComments = fields.Contains("comments")
+		if !loadComments {
+			loadComments = fields.Contains("comments")
+		}
+		if !loadLinkedPRs {
+			loadLinkedPRs = fields.Contains("linkedPullRequests")
+		}
 	}
 
 	opts.IO.StartProgressIndicator()
-	issue, err := findIssue(httpClient, opts.BaseRepo, opts.SelectorArg, loadComments)
+	issue, err := findIssue(httpClient, opts.BaseRepo, opts.SelectorArg, loadComments, loadLinkedPRs)
 	opts.IO.StopProgressIndicator()
 	if err != nil {
 		return err
 	}
 
+	if opts.CommentsSince != "" {
+		since, _ := parseCommentsSince(opts.CommentsSince)
+		filterCommentsSince(&issue.Comments, since)
+	}
+	if opts.CommentsLast > 0 {
+		limitComments(&issue.Comments, opts.CommentsLast)
+	}
+
 	if opts.WebMode {
 		openURL := issue.URL
 		if opts.IO.IsStdoutTTY() {
@@ -120,7 +144,7 @@ func viewRun(opts *ViewOptions) error {
 		return printHumanIssuePreview(opts, issue)
 	}
 
-	if opts.Comments {
+	if loadComments {
 		fmt.Fprint(opts.IO.Out, prShared.RawCommentList(issue.Comments, api.PullRequestReviews{}))
 		return nil
 	}
@@ -128,7 +152,7 @@ func viewRun(opts *ViewOptions) error {
 	return printRawIssuePreview(opts.IO.Out, issue)
 }
 
-func findIssue(client *http.Client, baseRepoFn func() (ghrepo.Interface, error), selector string, loadComments bool) (*api.Issue, error) {
+func findIssue(client *http.Client, baseRepoFn func() (ghrepo.Interface, error), selector string, loadComments, loadLinkedPRs bool) (*api.Issue, error) {
 	apiClient := api.NewClientFromHTTP(client)
 	issue, repo, err := issueShared.IssueFromArg(apiClient, baseRepoFn, selector)
 	if err != nil {
@@ -136,7 +160,13 @@ func findIssue(client *http.Client, baseRepoFn func() (ghrepo.Interface, error),
 	}
 
 	if loadComments {
-		err = preloadIssueComments(client, repo, issue)
+		if err := preloadIssueComments(client, repo, issue); err != nil {
+			return issue, err
+		}
+	}
+
+	if loadLinkedPRs {
+		err = preloadLinkedPullRequests(client, repo, issue)
 	}
 	return issue, err
 }
@@ -150,6 +180,7 @@ func printRawIssuePreview(out io.Writer, issue *api.Issue) error {
 	// processing many issues with head and grep.
 	fmt.Fprintf(out, "title:\t%s\n", issue.Title)
 	fmt.Fprintf(out, "state:\t%s\n", issue.State)
+	fmt.Fprintf(out, "stateReason:\t%s\n", issue.StateReason)
 	fmt.Fprintf(out, "author:\t%s\n", issue.Author.Login)
 	fmt.Fprintf(out, "labels:\t%s\n", labels)
 	fmt.Fprintf(out, "comments:\t%d\n", issue.Comments.TotalCount)
@@ -181,6 +212,9 @@ func printHumanIssuePreview(opts *ViewOptions, issue *api.Issue) error {
 		utils.FuzzyAgo(ago),
 		utils.Pluralize(issue.Comments.TotalCount, "comment"),
 	)
+	if issue.StateReason == "NOT_PLANNED" {
+		fmt.Fprintf(out, "%s\n", cs.Gray("Closed as not planned"))
+	}
 
 	// Reactions
 	if reactions := prShared.ReactionGroupList(issue.ReactionGroups); reactions != "" {
@@ -206,6 +240,18 @@ func printHumanIssuePreview(opts *ViewOptions, issue *api.Issue) error {
 		fmt.Fprintln(out, issue.Milestone.Title)
 	}
 
+	// Linked pull requests
+	if prs := issue.LinkedPullRequests.Nodes; len(prs) > 0 {
+		fmt.Fprintln(out, cs.Bold("Linked pull requests"))
+		for _, pr := range prs {
+			fmt.Fprintf(out, "  #%d  %s  %s\n", pr.Number, pr.Title, linkedPullRequestStateTitleWithColor(cs, pr))
+		}
+		if remaining := issue.LinkedPullRequests.TotalCount - len(prs); remaining > 0 {
+			fmt.Fprintln(out, cs.Grayf("  and %d more", remaining))
+		}
+		fmt.Fprintln(out)
+	}
+
 	// Body
 	var md string
 	var err error
@@ -222,7 +268,7 @@ func printHumanIssuePreview(opts *ViewOptions, issue *api.Issue) error {
 
 	// Comments
 	if issue.Comments.TotalCount > 0 {
-		preview := !opts.Comments
+		preview := !opts.Comments && opts.CommentsSince == "" && opts.CommentsLast == 0
 		comments, err := prShared.CommentList(opts.IO, issue.Comments, api.PullRequestReviews{}, preview)
 		if err != nil {
 			return err
@@ -236,11 +282,41 @@ func printHumanIssuePreview(opts *ViewOptions, issue *api.Issue) error {
 	return nil
 }
 
+func parseCommentsSince(since string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", since)
+}
+
+func filterCommentsSince(comments *api.Comments, since time.Time) {
+	filtered := comments.Nodes[:0]
+	for _, c := range comments.Nodes {
+		if !c.CreatedAt.Before(since) {
+			filtered = append(filtered, c)
+		}
+	}
+	comments.Nodes = filtered
+}
+
+func limitComments(comments *api.Comments, last int) {
+	if len(comments.Nodes) > last {
+		comments.Nodes = comments.Nodes[len(comments.Nodes)-last:]
+	}
+}
+
 func issueStateTitleWithColor(cs *iostreams.ColorScheme, state string) string {
 	colorFunc := cs.ColorFromString(prShared.ColorForState(state))
 	return colorFunc(strings.Title(strings.ToLower(state)))
 }
 
+func linkedPullRequestStateTitleWithColor(cs *iostreams.ColorScheme, pr api.LinkedPullRequest) string {
+	if pr.State == "OPEN" && pr.IsDraft {
+		return cs.Gray(strings.Title(strings.ToLower("Draft")))
+	}
+	return issueStateTitleWithColor(cs, pr.State)
+}
+
 func issueAssigneeList(issue api.Issue) string {
 	if len(issue.Assignees.Nodes) == 0 {
 		return ""
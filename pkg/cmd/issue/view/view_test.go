@@ -74,6 +74,9 @@ func TestIssueView_web(t *testing.T) {
 				"url": "https://github.com/OWNER/REPO/issues/123"
 			} } } }
 		`))
+	reg.Register(
+		httpmock.GraphQL(`query LinkedPullRequestsForIssue\b`),
+		httpmock.StringResponse(`{ "data": { "node": {} } }`))
 
 	_, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
@@ -153,6 +156,17 @@ func TestIssueView_nontty_Preview(t *testing.T) {
 				`\*\*bold story\*\*`,
 			},
 		},
+		"Closed issue with state reason": {
+			fixture: "./fixtures/issueView_previewClosedStateReason.json",
+			expectedOutputs: []string{
+				`title:\tix of coins`,
+				`state:\tCLOSED`,
+				`stateReason:\tNOT_PLANNED`,
+				`author:\tmarseilles`,
+				`labels:\ttarot`,
+				`number:\t123\n`,
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -220,6 +234,16 @@ func TestIssueView_tty_Preview(t *testing.T) {
 				`View this issue on GitHub: https://github.com/OWNER/REPO/issues/123`,
 			},
 		},
+		"Closed issue with state reason": {
+			fixture: "./fixtures/issueView_previewClosedStateReason.json",
+			expectedOutputs: []string{
+				`ix of coins #123`,
+				`Closed.*marseilles opened about 9 years ago.*9 comments`,
+				`Closed as not planned`,
+				`bold story`,
+				`View this issue on GitHub: https://github.com/OWNER/REPO/issues/123`,
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -232,6 +256,7 @@ func TestIssueView_tty_Preview(t *testing.T) {
 			defer httpReg.Verify(t)
 
 			httpReg.Register(httpmock.GraphQL(`query IssueByNumber\b`), httpmock.FileResponse(tc.fixture))
+			httpReg.Register(httpmock.GraphQL(`query LinkedPullRequestsForIssue\b`), httpmock.StringResponse(`{ "data": { "node": {} } }`))
 
 			opts := ViewOptions{
 				IO: io,
@@ -351,6 +376,36 @@ func TestIssueView_tty_Comments(t *testing.T) {
 			cli:      "123 --comments 3",
 			wantsErr: true,
 		},
+		"with last flag": {
+			cli: "123 --last 1",
+			fixtures: map[string]string{
+				"IssueByNumber":    "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForIssue": "./fixtures/issueView_previewFullComments.json",
+			},
+			expectedOutputs: []string{
+				`some title #123`,
+				`some body`,
+				`marseilles \(Collaborator\) • Jan  1, 2020 • Newest comment`,
+				`Comment 5`,
+				`View this issue on GitHub: https://github.com/OWNER/REPO/issues/123`,
+			},
+		},
+		"with comments-since flag in the future": {
+			cli: "123 --comments-since 2099-01-01",
+			fixtures: map[string]string{
+				"IssueByNumber":    "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForIssue": "./fixtures/issueView_previewFullComments.json",
+			},
+			expectedOutputs: []string{
+				`some title #123`,
+				`some body`,
+				`View this issue on GitHub: https://github.com/OWNER/REPO/issues/123`,
+			},
+		},
+		"with invalid comments-since flag": {
+			cli:      "123 --comments-since not-a-date",
+			wantsErr: true,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -360,6 +415,9 @@ func TestIssueView_tty_Comments(t *testing.T) {
 				name := fmt.Sprintf(`query %s\b`, name)
 				http.Register(httpmock.GraphQL(name), httpmock.FileResponse(file))
 			}
+			if !tc.wantsErr {
+				http.Register(httpmock.GraphQL(`query LinkedPullRequestsForIssue\b`), httpmock.StringResponse(`{ "data": { "node": {} } }`))
+			}
 			output, err := runCommand(http, true, tc.cli)
 			if tc.wantsErr {
 				assert.Error(t, err)
@@ -373,6 +431,38 @@ func TestIssueView_tty_Comments(t *testing.T) {
 	}
 }
 
+func TestIssueView_tty_LinkedPullRequests(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query IssueByNumber\b`), httpmock.FileResponse("./fixtures/issueView_preview.json"))
+	http.Register(
+		httpmock.GraphQL(`query LinkedPullRequestsForIssue\b`),
+		httpmock.StringResponse(`
+			{ "data": { "node": { "closedByPullRequestsReferences": {
+				"totalCount": 12,
+				"nodes": [
+					{ "number": 1, "title": "Fix the thing", "url": "https://github.com/OWNER/REPO/pull/1", "state": "MERGED", "isDraft": false },
+					{ "number": 2, "title": "Work in progress", "url": "https://github.com/OWNER/REPO/pull/2", "state": "OPEN", "isDraft": true },
+					{ "number": 3, "title": "Abandoned attempt", "url": "https://github.com/OWNER/REPO/pull/3", "state": "CLOSED", "isDraft": false }
+				]
+			} } } }
+		`))
+
+	output, err := runCommand(http, true, "123")
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.Stderr())
+
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.String(),
+		`Linked pull requests`,
+		`#1  Fix the thing  Merged`,
+		`#2  Work in progress  Draft`,
+		`#3  Abandoned attempt  Closed`,
+		`and 9 more`,
+	)
+}
+
 func TestIssueView_nontty_Comments(t *testing.T) {
 	tests := map[string]struct {
 		cli             string
@@ -0,0 +1,22 @@
+package label
+
+import (
+	labelListCmd "github.com/cli/cli/pkg/cmd/label/list"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdLabel(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "label <command>",
+		Short: "Manage labels",
+		Long:  `Work with GitHub labels.`,
+		Annotations: map[string]string{
+			"IsCore": "true",
+		},
+	}
+
+	cmd.AddCommand(labelListCmd.NewCmdList(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/text"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List labels in a repository",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.LabelFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	labels, truncated, err := api.RepoLabels(apiClient, baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO.Out, labels, opts.IO.ColorEnabled())
+	}
+
+	if truncated {
+		fmt.Fprintf(opts.IO.ErrOut, "warning: more than %d labels found, showing only the first %d\n", api.RepoMetadataListLimit, api.RepoMetadataListLimit)
+	}
+
+	if len(labels) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "no labels found in this repository")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+
+	for _, label := range labels {
+		tp.AddField(label.Name, nil, cs.Bold)
+		tp.AddField(text.ReplaceExcessiveWhitespace(label.Description), nil, nil)
+		tp.AddField("#"+label.Color, nil, nil)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
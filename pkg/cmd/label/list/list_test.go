@@ -0,0 +1,117 @@
+package list
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*bytes.Buffer, *bytes.Buffer, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	cmd := NewCmdList(factory, nil)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return stdout, stderr, err
+}
+
+func TestListRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [
+				{ "name": "bug", "color": "d73a4a", "description": "Something isn't working", "isDefault": true },
+				{ "name": "enhancement", "color": "a2eeef", "description": "", "isDefault": true }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	stdout, stderr, err := runCommand(reg, false, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		bug	Something isn't working	#d73a4a
+		enhancement		#a2eeef
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestListRun_json(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [
+				{ "name": "bug", "color": "d73a4a", "description": "Something isn't working", "isDefault": true }
+			],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	stdout, stderr, err := runCommand(reg, false, "--json name,color,description,isDefault")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[{"color":"d73a4a","description":"Something isn't working","isDefault":true,"name":"bug"}]`, stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
+func TestListRun_noLabels(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	stdout, stderr, err := runCommand(reg, false, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "no labels found in this repository\n", stderr.String())
+}
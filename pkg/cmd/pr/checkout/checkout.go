@@ -33,6 +33,7 @@ type CheckoutOptions struct {
 	RecurseSubmodules bool
 	Force             bool
 	Detach            bool
+	LocalBranch       string
 }
 
 func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobra.Command {
@@ -65,6 +66,7 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 	cmd.Flags().BoolVarP(&opts.RecurseSubmodules, "recurse-submodules", "", false, "Update all submodules after checkout")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Reset the existing local branch to the latest state of the pull request")
 	cmd.Flags().BoolVarP(&opts.Detach, "detach", "", false, "Checkout PR with a detached HEAD")
+	cmd.Flags().StringVarP(&opts.LocalBranch, "branch", "b", "", "Local branch name to use (default [the name of the head branch])")
 
 	return cmd
 }
@@ -149,11 +151,16 @@ func cmdsForExistingRemote(remote *context.Remote, pr *api.PullRequest, opts *Ch
 
 	cmds = append(cmds, []string{"git", "fetch", remote.Name, refSpec})
 
+	localBranch := pr.HeadRefName
+	if opts.LocalBranch != "" {
+		localBranch = opts.LocalBranch
+	}
+
 	switch {
 	case opts.Detach:
 		cmds = append(cmds, []string{"git", "checkout", "--detach", "FETCH_HEAD"})
-	case localBranchExists(pr.HeadRefName):
-		cmds = append(cmds, []string{"git", "checkout", pr.HeadRefName})
+	case localBranchExists(localBranch):
+		cmds = append(cmds, []string{"git", "checkout", localBranch})
 		if opts.Force {
 			cmds = append(cmds, []string{"git", "reset", "--hard", fmt.Sprintf("refs/remotes/%s", remoteBranch)})
 		} else {
@@ -161,9 +168,9 @@ func cmdsForExistingRemote(remote *context.Remote, pr *api.PullRequest, opts *Ch
 			cmds = append(cmds, []string{"git", "merge", "--ff-only", fmt.Sprintf("refs/remotes/%s", remoteBranch)})
 		}
 	default:
-		cmds = append(cmds, []string{"git", "checkout", "-b", pr.HeadRefName, "--no-track", remoteBranch})
-		cmds = append(cmds, []string{"git", "config", fmt.Sprintf("branch.%s.remote", pr.HeadRefName), remote.Name})
-		cmds = append(cmds, []string{"git", "config", fmt.Sprintf("branch.%s.merge", pr.HeadRefName), "refs/heads/" + pr.HeadRefName})
+		cmds = append(cmds, []string{"git", "checkout", "-b", localBranch, "--no-track", remoteBranch})
+		cmds = append(cmds, []string{"git", "config", fmt.Sprintf("branch.%s.remote", localBranch), remote.Name})
+		cmds = append(cmds, []string{"git", "config", fmt.Sprintf("branch.%s.merge", localBranch), "refs/heads/" + pr.HeadRefName})
 	}
 
 	return cmds
@@ -173,8 +180,10 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 	var cmds [][]string
 
 	newBranchName := pr.HeadRefName
-	// avoid naming the new branch the same as the default branch
-	if newBranchName == defaultBranch {
+	if opts.LocalBranch != "" {
+		newBranchName = opts.LocalBranch
+	} else if newBranchName == defaultBranch {
+		// avoid naming the new branch the same as the default branch
 		newBranchName = fmt.Sprintf("%s/%s", pr.HeadRepositoryOwner.Login, newBranchName)
 	}
 
@@ -243,6 +243,28 @@ func TestPRCheckout_existingBranch(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func TestPRCheckout_sameRepo_localBranchOverride(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, pr := stubPR("OWNER/REPO", "OWNER/REPO:feature")
+	shared.RunCommandFinder("123", pr, baseRepo)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature`, 0, "")
+	cs.Register(`git show-ref --verify -- refs/heads/mybranch`, 1, "")
+	cs.Register(`git checkout -b mybranch --no-track origin/feature`, 0, "")
+	cs.Register(`git config branch\.mybranch\.remote origin`, 0, "")
+	cs.Register(`git config branch\.mybranch\.merge refs/heads/feature`, 0, "")
+
+	output, err := runCommand(http, nil, "master", `123 --branch mybranch`)
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
 func TestPRCheckout_differentRepo_remoteExists(t *testing.T) {
 	remotes := context.Remotes{
 		{
@@ -399,6 +421,29 @@ func TestPRCheckout_maintainerCanModify(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func TestPRCheckout_differentRepo_localBranchOverride(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, pr := stubPR("OWNER/REPO:master", "hubot/REPO:feature")
+	pr.MaintainerCanModify = true
+	shared.RunCommandFinder("123", pr, baseRepo)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git fetch origin refs/pull/123/head:mybranch`, 0, "")
+	cs.Register(`git config branch\.mybranch\.merge`, 1, "")
+	cs.Register(`git checkout mybranch`, 0, "")
+	cs.Register(`git config branch\.mybranch\.remote https://github\.com/hubot/REPO\.git`, 0, "")
+	cs.Register(`git config branch\.mybranch\.merge refs/heads/feature`, 0, "")
+
+	output, err := runCommand(http, nil, "master", `123 --branch mybranch`)
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
 func TestPRCheckout_recurseSubmodules(t *testing.T) {
 	http := &httpmock.Registry{}
 
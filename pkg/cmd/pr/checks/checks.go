@@ -3,10 +3,14 @@ package checks
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
@@ -20,19 +24,65 @@ type browser interface {
 }
 
 type ChecksOptions struct {
-	IO      *iostreams.IOStreams
-	Browser browser
+	IO         *iostreams.IOStreams
+	Browser    browser
+	HttpClient func() (*http.Client, error)
 
 	Finder shared.PRFinder
 
 	SelectorArg string
 	WebMode     bool
+	Required    bool
+	Watch       bool
+	FailFast    bool
+	Interval    int
+	Exporter    cmdutil.Exporter
+}
+
+const defaultInterval int = 10
+
+// CheckFields lists the fields of Check that are available to the --json flag.
+var CheckFields = []string{
+	"name",
+	"state",
+	"conclusion",
+	"startedAt",
+	"completedAt",
+	"detailsUrl",
+	"workflowName",
+}
+
+// Check is the structured representation of a single check in a pull request's status
+// check rollup, used for --json output.
+type Check struct {
+	Name         string    `json:"name"`
+	State        string    `json:"state"`
+	Conclusion   string    `json:"conclusion"`
+	StartedAt    time.Time `json:"startedAt"`
+	CompletedAt  time.Time `json:"completedAt"`
+	DetailsURL   string    `json:"detailsUrl"`
+	WorkflowName string    `json:"workflowName"`
+}
+
+func (c *Check) ExportData(fields []string) *map[string]interface{} {
+	v := reflect.ValueOf(c).Elem()
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(f, name)
+		})
+		data[f] = sf.Interface()
+	}
+
+	return &data
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
 	opts := &ChecksOptions{
-		IO:      f.IOStreams,
-		Browser: f.Browser,
+		IO:         f.IOStreams,
+		Browser:    f.Browser,
+		HttpClient: f.HttpClient,
 	}
 
 	cmd := &cobra.Command{
@@ -42,7 +92,15 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 			Show CI status for a single pull request.
 
 			Without an argument, the pull request that belongs to the current branch
-			is selected.			
+			is selected.
+
+			Use '--watch' to poll for updates until all checks have concluded, with
+			'--interval' to control how often the state is refreshed. The command exits
+			with a non-zero status if any checks failed.
+		`),
+		Example: heredoc.Doc(`
+			$ gh pr checks --watch
+			$ gh pr checks --watch --fail-fast
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -56,6 +114,22 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 				opts.SelectorArg = args[0]
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"the `--watch` flag is not supported with `--web`",
+				opts.Watch,
+				opts.WebMode,
+			); err != nil {
+				return err
+			}
+
+			if opts.FailFast && !opts.Watch {
+				return &cmdutil.FlagError{Err: errors.New("the `--fail-fast` flag requires `--watch`")}
+			}
+
+			if opts.Watch && opts.Exporter != nil {
+				return &cmdutil.FlagError{Err: errors.New("the `--watch` flag is not supported with `--json`")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -65,10 +139,56 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to show details about checks")
+	cmd.Flags().BoolVar(&opts.Required, "required", false, "Only show checks that are required")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Watch checks until they finish")
+	cmd.Flags().BoolVar(&opts.FailFast, "fail-fast", false, "Exit watch mode on first check failure")
+	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", defaultInterval, "Refresh interval in seconds when using --watch")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, CheckFields)
 
 	return cmd
 }
 
+// requiredStatusChecks fetches the names of the status checks that are required to pass
+// on the given branch, as configured in the branch's protection rules.
+func requiredStatusChecks(client *api.Client, repo ghrepo.Interface, branch string) ([]string, error) {
+	var result struct {
+		RequiredStatusChecks struct {
+			Contexts []string `json:"contexts"`
+		} `json:"required_status_checks"`
+	}
+
+	path := fmt.Sprintf("repos/%s/branches/%s/protection", ghrepo.FullName(repo), branch)
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			return nil, fmt.Errorf("branch '%s' is not protected", branch)
+		}
+		return nil, err
+	}
+
+	return result.RequiredStatusChecks.Contexts, nil
+}
+
+type output struct {
+	mark         string
+	bucket       string
+	name         string
+	elapsed      string
+	link         string
+	state        string
+	conclusion   string
+	startedAt    time.Time
+	completedAt  time.Time
+	workflowName string
+}
+
+type checkCounts struct {
+	Failed  int
+	Pending int
+	Passed  int
+}
+
 func checksRun(opts *ChecksOptions) error {
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
@@ -92,37 +212,134 @@ func checksRun(opts *ChecksOptions) error {
 		return opts.Browser.Browse(openURL)
 	}
 
+	var required []string
+	if opts.Required {
+		httpClient, err := opts.HttpClient()
+		if err != nil {
+			return err
+		}
+		apiClient := api.NewClientFromHTTP(httpClient)
+		required, err = requiredStatusChecks(apiClient, baseRepo, pr.BaseRefName)
+		if err != nil {
+			return fmt.Errorf("could not determine required status checks: %w", err)
+		}
+	}
+
+	if opts.Watch {
+		return watchChecksRun(opts, findOptions, required, pr)
+	}
+
+	outputs, counts, err := aggregateChecks(pr, required)
+	if err != nil {
+		return err
+	}
+
+	return renderChecks(opts, outputs, counts, isTerminal)
+}
+
+// watchChecksRun polls the pull request's checks on an interval, redrawing the output in
+// place on a TTY and printing a new line of updates for each poll otherwise, until no
+// checks remain pending (or, with FailFast, until any check fails).
+func watchChecksRun(opts *ChecksOptions, findOptions shared.FindOptions, required []string, pr *api.PullRequest) error {
+	isTerminal := opts.IO.IsStdoutTTY()
+	duration, err := time.ParseDuration(fmt.Sprintf("%ds", opts.Interval))
+	if err != nil {
+		return fmt.Errorf("could not parse interval: %w", err)
+	}
+
+	opts.IO.EnableVirtualTerminalProcessing()
+
+	var outputs []output
+	var counts checkCounts
+
+	for first := true; ; first = false {
+		if !first {
+			pr, _, err = opts.Finder.Find(findOptions)
+			if err != nil {
+				return err
+			}
+		}
+
+		outputs, counts, err = aggregateChecks(pr, required)
+		if err != nil {
+			return err
+		}
+
+		if isTerminal {
+			// Move cursor to 0,0 and clear from cursor to bottom of screen
+			fmt.Fprint(opts.IO.Out, "\x1b[0;0H\x1b[J")
+			fmt.Fprintln(opts.IO.Out, opts.IO.ColorScheme().Boldf("Refreshing checks every %d seconds. Press Ctrl+C to quit.", opts.Interval))
+			fmt.Fprintln(opts.IO.Out)
+		}
+
+		if err := renderChecks(opts, outputs, counts, isTerminal); err != nil {
+			return err
+		}
+
+		if counts.Pending == 0 {
+			break
+		}
+		if opts.FailFast && counts.Failed > 0 {
+			break
+		}
+
+		time.Sleep(duration)
+	}
+
+	if counts.Failed > 0 {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+// aggregateChecks flattens the pull request's status check rollup into a sorted slice of
+// outputs, optionally filtered down to the named required checks.
+func aggregateChecks(pr *api.PullRequest, required []string) ([]output, checkCounts, error) {
+	var counts checkCounts
+
 	if len(pr.StatusCheckRollup.Nodes) == 0 {
-		return fmt.Errorf("no commit found on the pull request")
+		return nil, counts, fmt.Errorf("no commit found on the pull request")
 	}
 
 	rollup := pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes
 	if len(rollup) == 0 {
-		return fmt.Errorf("no checks reported on the '%s' branch", pr.BaseRefName)
+		return nil, counts, fmt.Errorf("no checks reported on the '%s' branch", pr.BaseRefName)
 	}
 
-	passing := 0
-	failing := 0
-	pending := 0
+	if len(required) > 0 {
+		isRequired := func(name string) bool {
+			for _, r := range required {
+				if r == name {
+					return true
+				}
+			}
+			return false
+		}
+
+		filtered := rollup[:0]
+		for _, c := range rollup {
+			name := c.Name
+			if name == "" {
+				name = c.Context
+			}
+			if isRequired(name) {
+				filtered = append(filtered, c)
+			}
+		}
+		rollup = filtered
 
-	type output struct {
-		mark      string
-		bucket    string
-		name      string
-		elapsed   string
-		link      string
-		markColor func(string) string
+		if len(rollup) == 0 {
+			return nil, counts, fmt.Errorf("no required checks reported on the '%s' branch", pr.BaseRefName)
+		}
 	}
 
-	cs := opts.IO.ColorScheme()
-
 	outputs := []output{}
 
-	for _, c := range pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes {
+	for _, c := range rollup {
 		mark := "✓"
 		bucket := "pass"
 		state := c.State
-		markColor := cs.Green
 		if state == "" {
 			if c.Status == "COMPLETED" {
 				state = c.Conclusion
@@ -132,16 +349,14 @@ func checksRun(opts *ChecksOptions) error {
 		}
 		switch state {
 		case "SUCCESS", "NEUTRAL", "SKIPPED":
-			passing++
+			counts.Passed++
 		case "ERROR", "FAILURE", "CANCELLED", "TIMED_OUT", "ACTION_REQUIRED":
 			mark = "X"
-			markColor = cs.Red
-			failing++
+			counts.Failed++
 			bucket = "fail"
 		default: // "EXPECTED", "REQUESTED", "WAITING", "QUEUED", "PENDING", "IN_PROGRESS", "STALE"
 			mark = "-"
-			markColor = cs.Yellow
-			pending++
+			counts.Pending++
 			bucket = "pending"
 		}
 
@@ -165,7 +380,18 @@ func checksRun(opts *ChecksOptions) error {
 			name = c.Context
 		}
 
-		outputs = append(outputs, output{mark, bucket, name, elapsed, link, markColor})
+		outputs = append(outputs, output{
+			mark:         mark,
+			bucket:       bucket,
+			name:         name,
+			elapsed:      elapsed,
+			link:         link,
+			state:        state,
+			conclusion:   c.Conclusion,
+			startedAt:    c.StartedAt,
+			completedAt:  c.CompletedAt,
+			workflowName: c.CheckSuite.WorkflowRun.Workflow.Name,
+		})
 	}
 
 	sort.Slice(outputs, func(i, j int) bool {
@@ -186,11 +412,53 @@ func checksRun(opts *ChecksOptions) error {
 		return (b0 == "fail") || (b0 == "pending" && b1 == "success")
 	})
 
+	return outputs, counts, nil
+}
+
+func markColorForBucket(cs *iostreams.ColorScheme, bucket string) func(string) string {
+	switch bucket {
+	case "fail":
+		return cs.Red
+	case "pending":
+		return cs.Yellow
+	default:
+		return cs.Green
+	}
+}
+
+// renderChecks prints the outcome of a single poll of checks, either as a table (in
+// default or --watch mode) or via the configured --json exporter.
+func renderChecks(opts *ChecksOptions, outputs []output, counts checkCounts, isTerminal bool) error {
+	cs := opts.IO.ColorScheme()
+
+	if opts.Exporter != nil {
+		checks := make([]*Check, len(outputs))
+		for i, o := range outputs {
+			checks[i] = &Check{
+				Name:         o.name,
+				State:        o.state,
+				Conclusion:   o.conclusion,
+				StartedAt:    o.startedAt,
+				CompletedAt:  o.completedAt,
+				DetailsURL:   o.link,
+				WorkflowName: o.workflowName,
+			}
+		}
+		if err := opts.Exporter.Write(opts.IO.Out, checks, opts.IO.ColorEnabled()); err != nil {
+			return err
+		}
+
+		if counts.Failed+counts.Pending > 0 {
+			return cmdutil.SilentError
+		}
+		return nil
+	}
+
 	tp := utils.NewTablePrinter(opts.IO)
 
 	for _, o := range outputs {
 		if isTerminal {
-			tp.AddField(o.mark, nil, o.markColor)
+			tp.AddField(o.mark, nil, markColorForBucket(cs, o.bucket))
 			tp.AddField(o.name, nil, nil)
 			tp.AddField(o.elapsed, nil, nil)
 			tp.AddField(o.link, nil, nil)
@@ -209,10 +477,10 @@ func checksRun(opts *ChecksOptions) error {
 	}
 
 	summary := ""
-	if failing+passing+pending > 0 {
-		if failing > 0 {
+	if counts.Failed+counts.Passed+counts.Pending > 0 {
+		if counts.Failed > 0 {
 			summary = "Some checks were not successful"
-		} else if pending > 0 {
+		} else if counts.Pending > 0 {
 			summary = "Some checks are still pending"
 		} else {
 			summary = "All checks were successful"
@@ -220,7 +488,7 @@ func checksRun(opts *ChecksOptions) error {
 
 		tallies := fmt.Sprintf(
 			"%d failing, %d successful, and %d pending checks",
-			failing, passing, pending)
+			counts.Failed, counts.Passed, counts.Pending)
 
 		summary = fmt.Sprintf("%s\n%s", cs.Bold(summary), tallies)
 	}
@@ -230,12 +498,11 @@ func checksRun(opts *ChecksOptions) error {
 		fmt.Fprintln(opts.IO.Out)
 	}
 
-	err = tp.Render()
-	if err != nil {
+	if err := tp.Render(); err != nil {
 		return err
 	}
 
-	if failing+pending > 0 {
+	if !opts.Watch && counts.Failed+counts.Pending > 0 {
 		return cmdutil.SilentError
 	}
 
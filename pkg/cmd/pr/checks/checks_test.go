@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"net/http"
 	"os"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cli/cli/internal/run"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
 	"github.com/cli/cli/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +38,16 @@ func TestNewCmdChecks(t *testing.T) {
 				SelectorArg: "1234",
 			},
 		},
+		{
+			name: "watch",
+			cli:  "1234 --watch --interval 5 --fail-fast",
+			wants: ChecksOptions{
+				SelectorArg: "1234",
+				Watch:       true,
+				Interval:    5,
+				FailFast:    true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,6 +74,11 @@ func TestNewCmdChecks(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+			assert.Equal(t, tt.wants.Watch, gotOpts.Watch)
+			assert.Equal(t, tt.wants.FailFast, gotOpts.FailFast)
+			if tt.wants.Interval != 0 {
+				assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+			}
 		})
 	}
 }
@@ -184,6 +201,84 @@ func Test_checksRun(t *testing.T) {
 	}
 }
 
+func Test_checksRun_required(t *testing.T) {
+	ff, err := os.Open("./fixtures/someFailing.json")
+	require.NoError(t, err)
+	defer ff.Close()
+	var response *api.PullRequest
+	dec := json.NewDecoder(ff)
+	require.NoError(t, dec.Decode(&response))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/branches/master/protection"),
+		httpmock.StringResponse(`{"required_status_checks": {"contexts": ["cool tests", "sad tests"]}}`),
+	)
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ChecksOptions{
+		IO:          ios,
+		SelectorArg: "123",
+		Required:    true,
+		Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+	}
+
+	err = checksRun(opts)
+	assert.EqualError(t, err, "SilentError")
+	assert.Equal(t, "sad tests\tfail\t1m26s\tsweet link\ncool tests\tpass\t1m26s\tsweet link\n", stdout.String())
+}
+
+func Test_checksRun_json(t *testing.T) {
+	ff, err := os.Open("./fixtures/someFailing.json")
+	require.NoError(t, err)
+	defer ff.Close()
+	var response *api.PullRequest
+	dec := json.NewDecoder(ff)
+	require.NoError(t, dec.Decode(&response))
+
+	ios, _, stdout, _ := iostreams.Test()
+
+	opts := &ChecksOptions{
+		IO:          ios,
+		SelectorArg: "123",
+		Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+		Exporter:    &checksTestExporter{fields: []string{"name", "state", "conclusion"}},
+	}
+
+	err = checksRun(opts)
+	assert.EqualError(t, err, "SilentError")
+
+	var checks []map[string]interface{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &checks))
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "sad tests", "state": "FAILURE", "conclusion": "FAILURE"},
+		{"name": "cool tests", "state": "SUCCESS", "conclusion": "SUCCESS"},
+		{"name": "slow tests", "state": "IN_PROGRESS", "conclusion": ""},
+	}, checks)
+}
+
+type checksTestExporter struct {
+	fields []string
+}
+
+func (e *checksTestExporter) Fields() []string {
+	return e.fields
+}
+
+func (e *checksTestExporter) Write(w io.Writer, data interface{}, colorize bool) error {
+	checks := data.([]*Check)
+	exported := make([]*map[string]interface{}, len(checks))
+	for i, c := range checks {
+		exported[i] = c.ExportData(e.fields)
+	}
+	return json.NewEncoder(w).Encode(exported)
+}
+
 func TestChecksRun_web(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -233,3 +328,52 @@ func TestChecksRun_web(t *testing.T) {
 		})
 	}
 }
+
+func Test_checksRun_watch(t *testing.T) {
+	ff, err := os.Open("./fixtures/allPassing.json")
+	require.NoError(t, err)
+	defer ff.Close()
+	var response *api.PullRequest
+	dec := json.NewDecoder(ff)
+	require.NoError(t, dec.Decode(&response))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ChecksOptions{
+		IO:          ios,
+		SelectorArg: "123",
+		Watch:       true,
+		Interval:    0,
+		Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+	}
+
+	err = checksRun(opts)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout.String(), "All checks were successful")
+}
+
+func Test_checksRun_watchFailFast(t *testing.T) {
+	ff, err := os.Open("./fixtures/someFailing.json")
+	require.NoError(t, err)
+	defer ff.Close()
+	var response *api.PullRequest
+	dec := json.NewDecoder(ff)
+	require.NoError(t, dec.Decode(&response))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(false)
+
+	opts := &ChecksOptions{
+		IO:          ios,
+		SelectorArg: "123",
+		Watch:       true,
+		FailFast:    true,
+		Interval:    0,
+		Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+	}
+
+	err = checksRun(opts)
+	assert.EqualError(t, err, "SilentError")
+	assert.Contains(t, stdout.String(), "sad tests\tfail")
+}
@@ -1,6 +1,7 @@
 package close
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -19,7 +20,7 @@ type CloseOptions struct {
 
 	Finder shared.PRFinder
 
-	SelectorArg       string
+	SelectorArgs      []string
 	DeleteBranch      bool
 	DeleteLocalBranch bool
 }
@@ -32,16 +33,12 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 	}
 
 	cmd := &cobra.Command{
-		Use:   "close {<number> | <url> | <branch>}",
+		Use:   "close [<number> | <url> | <branch>] ...",
 		Short: "Close a pull request",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
-
-			if len(args) > 0 {
-				opts.SelectorArg = args[0]
-			}
-
+			opts.SelectorArgs = args
 			opts.DeleteLocalBranch = !cmd.Flags().Changed("repo")
 
 			if runF != nil {
@@ -56,10 +53,28 @@ func NewCmdClose(f *cmdutil.Factory, runF func(*CloseOptions) error) *cobra.Comm
 }
 
 func closeRun(opts *CloseOptions) error {
+	selectors := opts.SelectorArgs
+	if len(selectors) == 0 {
+		selectors = []string{""}
+	}
+
+	var closeErr error
+	for _, selector := range selectors {
+		if err := closeOne(opts, selector); err != nil {
+			if !errors.Is(err, cmdutil.SilentError) {
+				fmt.Fprintf(opts.IO.ErrOut, "%s\n", err)
+			}
+			closeErr = cmdutil.SilentError
+		}
+	}
+	return closeErr
+}
+
+func closeOne(opts *CloseOptions, selector string) error {
 	cs := opts.IO.ColorScheme()
 
 	findOptions := shared.FindOptions{
-		Selector: opts.SelectorArg,
+		Selector: selector,
 		Fields:   []string{"state", "number", "title", "isCrossRepository", "headRefName"},
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
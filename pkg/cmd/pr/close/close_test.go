@@ -2,6 +2,7 @@ package close
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -115,6 +116,28 @@ func TestPrClose(t *testing.T) {
 	assert.Equal(t, "✓ Closed pull request #96 (The title of the PR)\n", output.Stderr())
 }
 
+func TestPrClose_noArgs(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, pr := stubPR("OWNER/REPO", "OWNER/REPO:trunk")
+	pr.Title = "The title of the PR"
+	shared.RunCommandFinder("", pr, baseRepo)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestClose\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["pullRequestId"], "THE-ID")
+			}),
+	)
+
+	output, err := runCommand(http, true, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Closed pull request #96 (The title of the PR)\n", output.Stderr())
+}
+
 func TestPrClose_alreadyClosed(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -196,6 +219,62 @@ func TestPrClose_deleteBranch_crossRepo(t *testing.T) {
 	`), output.Stderr())
 }
 
+// multiFinder resolves PRs by selector for tests that close more than one PR in a single invocation.
+type multiFinder struct {
+	prs  map[string]*api.PullRequest
+	repo ghrepo.Interface
+}
+
+func (f *multiFinder) Find(opts shared.FindOptions) (*api.PullRequest, ghrepo.Interface, error) {
+	pr, ok := f.prs[opts.Selector]
+	if !ok {
+		return nil, nil, fmt.Errorf("no pull request found for %q", opts.Selector)
+	}
+	return pr, f.repo, nil
+}
+
+func TestPrClose_multipleSelectors(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestClose\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`, func(inputs map[string]interface{}) {}),
+	)
+
+	baseRepo, err := ghrepo.FromFullName("OWNER/REPO")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &CloseOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		SelectorArgs: []string{"96", "97"},
+		Finder: &multiFinder{
+			repo: baseRepo,
+			prs: map[string]*api.PullRequest{
+				"96": {ID: "THE-ID", Number: 96, State: "OPEN", Title: "First PR"},
+				"97": {ID: "THE-ID", Number: 97, State: "CLOSED", Title: "Second PR"},
+			},
+		},
+	}
+
+	err = closeRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		✓ Closed pull request #96 (First PR)
+		! Pull request #97 (Second PR) is already closed
+	`), stderr.String())
+}
+
 func TestPrClose_deleteBranch_sameBranch(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
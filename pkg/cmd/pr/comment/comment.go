@@ -32,6 +32,9 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 			is selected.			
 
 			With '--web', comment on the pull request in a web browser instead.
+
+			With '--edit-last', edit the last comment you made on the pull request, instead of
+			adding a new one.
 		`),
 		Example: heredoc.Doc(`
 			$ gh pr comment 22 --body "This looks great, lets get it deployed."
@@ -74,6 +77,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file`")
 	cmd.Flags().BoolP("editor", "e", false, "Add body using editor")
 	cmd.Flags().BoolP("web", "w", false, "Add body in browser")
+	cmd.Flags().BoolVarP(&opts.EditLast, "edit-last", "", false, "Edit the last comment of the current user")
 
 	return cmd
 }
@@ -158,6 +158,23 @@ func TestNewCmdComment(t *testing.T) {
 			output:   shared.CommentableOptions{},
 			wantsErr: true,
 		},
+		{
+			name:  "edit-last flag",
+			input: "1 --edit-last --body test",
+			output: shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Body:        "test",
+				EditLast:    true,
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "edit-last and web flags",
+			input:    "1 --edit-last --web",
+			output:   shared.CommentableOptions{},
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -201,6 +218,7 @@ func TestNewCmdComment(t *testing.T) {
 			assert.Equal(t, tt.output.Interactive, gotOpts.Interactive)
 			assert.Equal(t, tt.output.InputType, gotOpts.InputType)
 			assert.Equal(t, tt.output.Body, gotOpts.Body)
+			assert.Equal(t, tt.output.EditLast, gotOpts.EditLast)
 		})
 	}
 }
@@ -265,6 +283,21 @@ func Test_commentRun(t *testing.T) {
 			},
 			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-456\n",
 		},
+		{
+			name: "non-interactive inline, edit last",
+			input: &shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Body:        "comment body",
+				EditLast:    true,
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				mockCurrentLogin(t, reg)
+				mockLastComment(t, reg)
+				mockCommentUpdate(t, reg)
+			},
+			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-789\n",
+		},
 	}
 	for _, tt := range tests {
 		io, _, stdout, stderr := iostreams.Test()
@@ -298,6 +331,37 @@ func Test_commentRun(t *testing.T) {
 	}
 }
 
+func mockCurrentLogin(_ *testing.T, reg *httpmock.Registry) {
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"login":"monalisa"}}}`),
+	)
+}
+
+func mockLastComment(t *testing.T, reg *httpmock.Registry) {
+	reg.Register(
+		httpmock.GraphQL(`query LastComment\b`),
+		httpmock.StringResponse(`
+			{ "data": { "node": { "comments": { "nodes": [
+				{ "id": "COMMENT-1", "author": { "login": "monalisa" } }
+			] } } } }`),
+	)
+}
+
+func mockCommentUpdate(t *testing.T, reg *httpmock.Registry) {
+	reg.Register(
+		httpmock.GraphQL(`mutation CommentUpdate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "updateIssueComment": { "issueComment": {
+			"url": "https://github.com/OWNER/REPO/pull/123#issuecomment-789"
+		} } } }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "COMMENT-1", inputs["id"])
+				assert.Equal(t, "comment body", inputs["body"])
+			}),
+	)
+}
+
 func mockCommentCreate(t *testing.T, reg *httpmock.Registry) {
 	reg.Register(
 		httpmock.GraphQL(`mutation CommentCreate\b`),
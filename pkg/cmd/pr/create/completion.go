@@ -0,0 +1,65 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// branchNameCompletionFunc returns a cobra completion function that suggests branch
+// names for the base repository, fetched once per invocation of `gh` and reused for
+// both --base and --head.
+func branchNameCompletionFunc(f *cmdutil.Factory) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	var cachedBranches []string
+	var cacheErr error
+	fetched := false
+
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if !fetched {
+			cachedBranches, cacheErr = listRepoBranches(f)
+			fetched = true
+		}
+		if cacheErr != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		var matches []string
+		for _, branch := range cachedBranches {
+			if strings.HasPrefix(branch, toComplete) {
+				matches = append(matches, branch)
+			}
+		}
+		return matches, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func listRepoBranches(f *cmdutil.Factory) ([]string, error) {
+	httpClient, err := f.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+	baseRepo, err := f.BaseRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	var branches []struct {
+		Name string `json:"name"`
+	}
+	path := fmt.Sprintf("repos/%s/branches?per_page=100", ghrepo.FullName(baseRepo))
+	if err := client.REST(baseRepo.RepoHost(), "GET", path, nil, &branches); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(branches))
+	for i, b := range branches {
+		names[i] = b.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,42 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchNameCompletionFunc(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/branches"),
+		httpmock.StringResponse(`[
+			{ "name": "main" },
+			{ "name": "feature/login" },
+			{ "name": "feature/logout" }
+		]`))
+
+	f := &cmdutil.Factory{
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	completion := branchNameCompletionFunc(f)
+
+	matches, _ := completion(nil, nil, "feature/")
+	assert.Equal(t, []string{"feature/login", "feature/logout"}, matches)
+
+	// A second call should reuse the cached branch list rather than issuing another request.
+	matches, _ = completion(nil, nil, "main")
+	assert.Equal(t, []string{"main"}, matches)
+}
@@ -1,11 +1,14 @@
 package create
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -46,6 +49,8 @@ type CreateOptions struct {
 
 	Autofill    bool
 	WebMode     bool
+	DryRun      bool
+	DryRunJSON  bool
 	RecoverFile string
 
 	IsDraft    bool
@@ -53,6 +58,7 @@ type CreateOptions struct {
 	Body       string
 	BaseBranch string
 	HeadBranch string
+	Template   string
 
 	Reviewers []string
 	Assignees []string
@@ -112,9 +118,11 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh pr create --title "The bug is fixed" --body "Everything works again"
+			$ gh pr create --title "The bug is fixed" --template "Bug Fix"
 			$ gh pr create --reviewer monalisa,hubot  --reviewer myorg/team-name
 			$ gh pr create --project "Roadmap"
 			$ gh pr create --base develop --head monalisa:feature
+			$ gh pr create --fill --dry-run
 		`),
 		Args: cmdutil.NoArgsQuoteReminder,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -142,6 +150,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			if cmd.Flags().Changed("no-maintainer-edit") && opts.WebMode {
 				return errors.New("the `--no-maintainer-edit` flag is not supported with `--web`")
 			}
+			if opts.DryRunJSON {
+				opts.DryRun = true
+			}
+			if opts.DryRun && opts.WebMode {
+				return errors.New("the `--dry-run` flag is not supported with `--web`")
+			}
 
 			opts.BodyProvided = cmd.Flags().Changed("body")
 			if bodyFile != "" {
@@ -153,6 +167,21 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				opts.BodyProvided = true
 			}
 
+			if !opts.BodyProvided && !opts.IO.IsStdinTTY() {
+				b, err := ioutil.ReadAll(opts.IO.In)
+				if err != nil {
+					return fmt.Errorf("failed to read body from STDIN: %w", err)
+				}
+				if len(b) > 0 {
+					opts.Body = string(b)
+					opts.BodyProvided = true
+				}
+			}
+
+			if opts.Template != "" && opts.BodyProvided {
+				return &cmdutil.FlagError{Err: errors.New("`--template` is not supported when using `--body` or `--body-file`")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -165,17 +194,24 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.StringVarP(&opts.Title, "title", "t", "", "Title for the pull request")
 	fl.StringVarP(&opts.Body, "body", "b", "", "Body for the pull request")
 	fl.StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file`")
+	fl.StringVarP(&opts.Template, "template", "T", "", "Template `name` to use as starting body text")
 	fl.StringVarP(&opts.BaseBranch, "base", "B", "", "The `branch` into which you want your code merged")
 	fl.StringVarP(&opts.HeadBranch, "head", "H", "", "The `branch` that contains commits for your pull request (default: current branch)")
+
+	branchCompletion := branchNameCompletionFunc(f)
+	_ = cmd.RegisterFlagCompletionFunc("base", branchCompletion)
+	_ = cmd.RegisterFlagCompletionFunc("head", branchCompletion)
 	fl.BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser to create a pull request")
 	fl.BoolVarP(&opts.Autofill, "fill", "f", false, "Do not prompt for title/body and just use commit info")
-	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people or teams by their `handle`")
+	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people or teams by their `handle`. To request a team, use the format `org/team-name`.")
 	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	fl.StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
 	fl.StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the pull request to projects by `name`")
 	fl.StringVarP(&opts.Milestone, "milestone", "m", "", "Add the pull request to a milestone by `name`")
 	fl.Bool("no-maintainer-edit", false, "Disable maintainer's ability to modify pull request")
 	fl.StringVar(&opts.RecoverFile, "recover", "", "Recover input from a failed run of create")
+	fl.BoolVar(&opts.DryRun, "dry-run", false, "Print details about the pull request rather than creating it")
+	fl.BoolVar(&opts.DryRunJSON, "dry-run-json", false, "Print the would-be API payload for the pull request as JSON (implies --dry-run)")
 
 	return cmd
 }
@@ -188,6 +224,17 @@ func createRun(opts *CreateOptions) (err error) {
 
 	client := ctx.Client
 
+	var templateContentFromFlag string
+	if !opts.BodyProvided && opts.Template != "" {
+		var template shared.Template
+		template, err = shared.NewTemplateManager(client.HTTP(), ctx.BaseRepo, opts.RootDirOverride, opts.RepoOverride == "", true).Select(opts.Template)
+		if err != nil {
+			return
+		}
+		templateContentFromFlag = string(template.Body())
+		opts.BodyProvided = true
+	}
+
 	state, err := NewIssueState(*ctx, *opts)
 	if err != nil {
 		return
@@ -198,7 +245,11 @@ func createRun(opts *CreateOptions) (err error) {
 	if opts.WebMode {
 		if !opts.Autofill {
 			state.Title = opts.Title
-			state.Body = opts.Body
+			if templateContentFromFlag != "" {
+				state.Body = templateContentFromFlag
+			} else {
+				state.Body = opts.Body
+			}
 		}
 		err = handlePush(*opts, *ctx)
 		if err != nil {
@@ -219,10 +270,16 @@ func createRun(opts *CreateOptions) (err error) {
 		state.Title = opts.Title
 	}
 
-	if opts.BodyProvided {
+	if templateContentFromFlag != "" {
+		state.Body = templateContentFromFlag
+	} else if opts.BodyProvided {
 		state.Body = opts.Body
 	}
 
+	if opts.DryRun {
+		return previewDryRun(*opts, *ctx, *state)
+	}
+
 	existingPR, _, err := opts.Finder.Find(shared.FindOptions{
 		Selector:   ctx.HeadBranchLabel,
 		BaseBranch: ctx.BaseBranch,
@@ -652,6 +709,10 @@ func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataS
 	opts.IO.StopProgressIndicator()
 	if pr != nil {
 		fmt.Fprintln(opts.IO.Out, pr.URL)
+		if opts.IO.IsStdoutTTY() && len(state.Projects) > 0 {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s Added to project(s): %s\n", cs.SuccessIcon(), strings.Join(state.Projects, ", "))
+		}
 	}
 	if err != nil {
 		if pr != nil {
@@ -662,6 +723,54 @@ func submitPR(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataS
 	return nil
 }
 
+func previewDryRun(opts CreateOptions, ctx CreateContext, state shared.IssueMetadataState) error {
+	if opts.DryRunJSON {
+		payload := map[string]interface{}{
+			"title":               state.Title,
+			"body":                state.Body,
+			"draft":               state.Draft,
+			"baseRefName":         ctx.BaseBranch,
+			"headRefName":         ctx.HeadBranchLabel,
+			"maintainerCanModify": opts.MaintainerCanModify,
+			"reviewers":           state.Reviewers,
+			"assignees":           state.Assignees,
+			"labels":              state.Labels,
+			"projects":            state.Projects,
+			"milestone":           state.Milestones,
+		}
+		enc := json.NewEncoder(opts.IO.Out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(payload)
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s %s into %s in %s\n\n",
+		cs.Bold("Would create pull request for"),
+		cs.Cyan(ctx.HeadBranchLabel),
+		cs.Cyan(ctx.BaseBranch),
+		ghrepo.FullName(ctx.BaseRepo))
+	fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("title:"), state.Title)
+	fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("draft:"), strconv.FormatBool(state.Draft))
+	if len(state.Reviewers) > 0 {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("reviewers:"), strings.Join(state.Reviewers, ", "))
+	}
+	if len(state.Assignees) > 0 {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("assignees:"), strings.Join(state.Assignees, ", "))
+	}
+	if len(state.Labels) > 0 {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("labels:"), strings.Join(state.Labels, ", "))
+	}
+	if len(state.Projects) > 0 {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("projects:"), strings.Join(state.Projects, ", "))
+	}
+	if len(state.Milestones) > 0 {
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.Bold("milestone:"), strings.Join(state.Milestones, ", "))
+	}
+	fmt.Fprintf(opts.IO.Out, "\n%s\n", state.Body)
+
+	return nil
+}
+
 func previewPR(opts CreateOptions, openURL string) error {
 	if opts.IO.IsStdinTTY() && opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", utils.DisplayURL(openURL))
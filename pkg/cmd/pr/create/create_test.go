@@ -105,6 +105,32 @@ func TestNewCmdCreate(t *testing.T) {
 				MaintainerCanModify: true,
 			},
 		},
+		{
+			name:     "template and body are mutually exclusive",
+			tty:      false,
+			cli:      `-t mytitle -b "a body" --template "Bug fix"`,
+			wantsErr: true,
+		},
+		{
+			name:     "body from piped stdin",
+			tty:      false,
+			stdin:    "a body piped from stdin",
+			cli:      "-t mytitle",
+			wantsErr: false,
+			wantsOpts: CreateOptions{
+				Title:               "mytitle",
+				TitleProvided:       true,
+				Body:                "a body piped from stdin",
+				BodyProvided:        true,
+				Autofill:            false,
+				RecoverFile:         "",
+				WebMode:             false,
+				IsDraft:             false,
+				BaseBranch:          "",
+				HeadBranch:          "",
+				MaintainerCanModify: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -361,6 +387,63 @@ func TestPRCreate_nontty(t *testing.T) {
 	assert.Equal(t, "https://github.com/OWNER/REPO/pull/12\n", output.String())
 }
 
+func TestPRCreate_dryRun(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder("feature", nil, nil)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git status --porcelain`, 0, "")
+
+	output, err := runCommand(http, nil, "feature", false, `-t "my title" -b "my body" -H feature --dry-run`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "", output.Stderr())
+	assert.Contains(t, output.String(), "Would create pull request for")
+	assert.Contains(t, output.String(), "title: my title")
+	assert.Contains(t, output.String(), "my body")
+}
+
+func TestPRCreate_dryRunJSON(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder("feature", nil, nil)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git status --porcelain`, 0, "")
+
+	output, err := runCommand(http, nil, "feature", false, `-t "my title" -b "my body" -H feature --dry-run-json`)
+	require.NoError(t, err)
+
+	var payload struct {
+		Title       string `json:"title"`
+		Body        string `json:"body"`
+		BaseRefName string `json:"baseRefName"`
+		HeadRefName string `json:"headRefName"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &payload))
+	assert.Equal(t, "my title", payload.Title)
+	assert.Equal(t, "my body", payload.Body)
+	assert.Equal(t, "master", payload.BaseRefName)
+	assert.Equal(t, "feature", payload.HeadRefName)
+}
+
+func TestPRCreate_dryRunWithWeb(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, nil, "feature", true, `--web --dry-run --head=feature`)
+	assert.EqualError(t, err, "the `--dry-run` flag is not supported with `--web`")
+}
+
 func TestPRCreate(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -640,10 +723,60 @@ func TestPRCreate_nonLegacyTemplate(t *testing.T) {
 	assert.Equal(t, "https://github.com/OWNER/REPO/pull/12\n", output.String())
 }
 
+func TestPRCreate_nonLegacyTemplate_templateFlag_nontty(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder("feature", nil, nil)
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "createPullRequest": { "pullRequest": {
+			"URL": "https://github.com/OWNER/REPO/pull/12"
+		} } } }
+		`, func(input map[string]interface{}) {
+			assert.Equal(t, "my title", input["title"].(string))
+			assert.Equal(t, "Fixes a bug and Closes an issue", input["body"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git status --porcelain`, 0, "")
+
+	output, err := runCommandWithRootDirOverridden(http, nil, "feature", false, `-t "my title" -H feature --template "Bug fix"`, "./fixtures/repoWithNonLegacyPRTemplates")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://github.com/OWNER/REPO/pull/12\n", output.String())
+}
+
+func TestPRCreate_templateNotFound(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.StubRepoInfoResponse("OWNER", "REPO", "master")
+	shared.RunCommandFinder("feature", nil, nil)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git status --porcelain`, 0, "")
+
+	_, err := runCommandWithRootDirOverridden(http, nil, "feature", false, `-t "my title" -H feature --template "Feature request"`, "./fixtures/repoWithNonLegacyPRTemplates")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `no template named "Feature request"`)
+}
+
 func TestPRCreate_metadata(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
 
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git status --porcelain`, 0, " M a.go\n M b.go\n M c.go\n M d.go\n M e.go\n M f.go\n")
+
 	http.StubRepoInfoResponse("OWNER", "REPO", "master")
 	shared.RunCommandFinder("feature", nil, nil)
 	http.Register(
@@ -739,6 +872,7 @@ func TestPRCreate_metadata(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, "https://github.com/OWNER/REPO/pull/12\n", output.String())
+	assert.Equal(t, "Warning: 6 uncommitted changes\n\nCreating pull request for feature into master in OWNER/REPO\n\n✓ Added to project(s): roadmap\n", output.Stderr())
 }
 
 func TestPRCreate_alreadyExists(t *testing.T) {
@@ -25,6 +25,8 @@ type DiffOptions struct {
 
 	SelectorArg string
 	UseColor    string
+	NameOnly    bool
+	NameStatus  bool
 }
 
 func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
@@ -62,6 +64,14 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 				opts.UseColor = "never"
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--name-only` or `--name-status`",
+				opts.NameOnly,
+				opts.NameStatus,
+			); err != nil {
+				return err
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -70,6 +80,8 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().StringVar(&opts.UseColor, "color", "auto", "Use color in diff output: {always|never|auto}")
+	cmd.Flags().BoolVar(&opts.NameOnly, "name-only", false, "Display only names of changed files")
+	cmd.Flags().BoolVar(&opts.NameStatus, "name-status", false, "Display only names and statuses of changed files")
 
 	return cmd
 }
@@ -90,6 +102,23 @@ func diffRun(opts *DiffOptions) error {
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
 
+	if opts.NameOnly || opts.NameStatus {
+		files, err := apiClient.PullRequestChangedFiles(baseRepo, pr.Number)
+		if err != nil {
+			return fmt.Errorf("could not find pull request changed files: %w", err)
+		}
+
+		for _, f := range files {
+			if opts.NameStatus {
+				fmt.Fprintf(opts.IO.Out, "%s\t%s\n", nameStatusLetter(f.Status), f.Path)
+			} else {
+				fmt.Fprintln(opts.IO.Out, f.Path)
+			}
+		}
+
+		return nil
+	}
+
 	diff, err := apiClient.PullRequestDiff(baseRepo, pr.Number)
 	if err != nil {
 		return fmt.Errorf("could not find pull request diff: %w", err)
@@ -154,3 +183,22 @@ func isRemovalLine(dl string) bool {
 func validColorFlag(c string) bool {
 	return c == "auto" || c == "always" || c == "never"
 }
+
+func nameStatusLetter(status string) string {
+	switch status {
+	case "added":
+		return "A"
+	case "removed":
+		return "D"
+	case "modified":
+		return "M"
+	case "renamed":
+		return "R"
+	case "copied":
+		return "C"
+	case "changed":
+		return "M"
+	default:
+		return "M"
+	}
+}
@@ -67,6 +67,32 @@ func Test_NewCmdDiff(t *testing.T) {
 			isTTY:   true,
 			wantErr: `did not understand color: "doublerainbow". Expected one of always, never, or auto`,
 		},
+		{
+			name:  "name-only",
+			args:  "--name-only",
+			isTTY: true,
+			want: DiffOptions{
+				SelectorArg: "",
+				UseColor:    "auto",
+				NameOnly:    true,
+			},
+		},
+		{
+			name:  "name-status",
+			args:  "--name-status",
+			isTTY: true,
+			want: DiffOptions{
+				SelectorArg: "",
+				UseColor:    "auto",
+				NameStatus:  true,
+			},
+		},
+		{
+			name:    "name-only and name-status together",
+			args:    "--name-only --name-status",
+			isTTY:   true,
+			wantErr: "specify only one of `--name-only` or `--name-status`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -104,6 +130,8 @@ func Test_NewCmdDiff(t *testing.T) {
 
 			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
 			assert.Equal(t, tt.want.UseColor, opts.UseColor)
+			assert.Equal(t, tt.want.NameOnly, opts.NameOnly)
+			assert.Equal(t, tt.want.NameStatus, opts.NameStatus)
 		})
 	}
 }
@@ -159,6 +187,24 @@ func TestPRDiff_notty(t *testing.T) {
 	}
 }
 
+func TestPRDiff_notty_color(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", &api.PullRequest{Number: 123}, ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123"),
+		httpmock.StringResponse(testDiff),
+	)
+
+	output, err := runCommand(http, nil, false, "123 --color always")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Contains(t, output.String(), "\x1b[32m+site: bin/gh\x1b[m")
+}
+
 func TestPRDiff_tty(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -177,6 +223,49 @@ func TestPRDiff_tty(t *testing.T) {
 	assert.Contains(t, output.String(), "\x1b[32m+site: bin/gh\x1b[m")
 }
 
+func TestPRDiff_name_only(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", &api.PullRequest{Number: 123}, ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+		httpmock.StringResponse(`[
+			{ "filename": "foo.go", "status": "modified" },
+			{ "filename": "bar.go", "status": "added" }
+		]`),
+	)
+
+	output, err := runCommand(http, nil, false, "123 --name-only")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, "foo.go\nbar.go\n", output.String())
+}
+
+func TestPRDiff_name_status(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("123", &api.PullRequest{Number: 123}, ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+		httpmock.StringResponse(`[
+			{ "filename": "foo.go", "status": "modified" },
+			{ "filename": "bar.go", "status": "added" },
+			{ "filename": "baz.go", "status": "removed" }
+		]`),
+	)
+
+	output, err := runCommand(http, nil, false, "123 --name-status")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, "M\tfoo.go\nA\tbar.go\nD\tbaz.go\n", output.String())
+}
+
 const testDiff = `diff --git a/.github/workflows/releases.yml b/.github/workflows/releases.yml
 index 73974448..b7fc0154 100644
 --- a/.github/workflows/releases.yml
@@ -3,6 +3,8 @@ package list
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
@@ -10,9 +12,35 @@ import (
 	"github.com/cli/cli/pkg/githubsearch"
 )
 
-func listPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.PullRequestAndTotalCount, error) {
-	if filters.Author != "" || filters.Assignee != "" || filters.Search != "" || len(filters.Labels) > 0 {
-		return searchPullRequests(httpClient, repo, filters, limit)
+// prOrderByField translates the --sort flag into the PullRequestOrderField value
+// accepted by the GraphQL API. "popularity" and "long-running" have no server-side
+// ordering outside of the search index, so callers must require --search before
+// reaching this function with those values.
+func prOrderByField(sort string) (string, error) {
+	switch sort {
+	case "", "created":
+		return "CREATED_AT", nil
+	case "updated":
+		return "UPDATED_AT", nil
+	default:
+		return "", fmt.Errorf("unsupported sort field for non-search pr list: %s", sort)
+	}
+}
+
+func listPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int, countOnly bool) (*api.PullRequestAndTotalCount, error) {
+	if filters.Author != "" || len(filters.Assignee) > 0 || filters.Search != "" || len(filters.Labels) > 0 || filters.Milestone != "" || filters.Created != "" || filters.Updated != "" {
+		if filters.Milestone != "" && !strings.EqualFold(filters.Milestone, "none") {
+			apiClient := api.NewClientFromHTTP(httpClient)
+			if milestoneNumber, err := strconv.ParseInt(filters.Milestone, 10, 32); err == nil {
+				milestone, err := api.MilestoneByNumber(apiClient, repo, int32(milestoneNumber))
+				if err != nil {
+					return nil, err
+				}
+				filters.Milestone = milestone.Title
+			}
+		}
+
+		return searchPullRequests(httpClient, repo, filters, limit, countOnly)
 	}
 
 	type response struct {
@@ -28,15 +56,40 @@ func listPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters pr
 		}
 	}
 
+	orderByField, err := prOrderByField(filters.Sort)
+	if err != nil {
+		return nil, err
+	}
+	orderByDirection := "DESC"
+	if filters.Order == "asc" {
+		orderByDirection = "ASC"
+	}
+
+	pullRequestsSelection := `
+					totalCount
+					nodes {
+						...pr
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}`
 	fragment := fmt.Sprintf("fragment pr on PullRequest{%s}", api.PullRequestGraphQL(filters.Fields))
-	query := fragment + `
+	if countOnly {
+		pullRequestsSelection = `totalCount`
+		fragment = ""
+	}
+
+	query := fragment + fmt.Sprintf(`
 		query PullRequestList(
 			$owner: String!,
 			$repo: String!,
 			$limit: Int!,
 			$endCursor: String,
 			$baseBranch: String,
-			$state: [PullRequestState!] = OPEN
+			$state: [PullRequestState!] = OPEN,
+			$orderByField: IssueOrderField!,
+			$orderByDirection: OrderDirection!
 		) {
 			repository(owner: $owner, name: $repo) {
 				pullRequests(
@@ -44,24 +97,19 @@ func listPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters pr
 					baseRefName: $baseBranch,
 					first: $limit,
 					after: $endCursor,
-					orderBy: {field: CREATED_AT, direction: DESC}
+					orderBy: {field: $orderByField, direction: $orderByDirection}
 				) {
-					totalCount
-					nodes {
-						...pr
-					}
-					pageInfo {
-						hasNextPage
-						endCursor
-					}
+					%s
 				}
 			}
-		}`
+		}`, pullRequestsSelection)
 
 	pageLimit := min(limit, 100)
 	variables := map[string]interface{}{
-		"owner": repo.RepoOwner(),
-		"repo":  repo.RepoName(),
+		"owner":            repo.RepoOwner(),
+		"repo":             repo.RepoName(),
+		"orderByField":     orderByField,
+		"orderByDirection": orderByDirection,
 	}
 
 	switch filters.State {
@@ -119,7 +167,11 @@ loop:
 	return &res, nil
 }
 
-func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int) (*api.PullRequestAndTotalCount, error) {
+// searchResultsLimit is the maximum number of results the GitHub search API will return
+// for a single query, regardless of how many results actually match.
+const searchResultsLimit = 1000
+
+func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters prShared.FilterOptions, limit int, countOnly bool) (*api.PullRequestAndTotalCount, error) {
 	type response struct {
 		Search struct {
 			Nodes    []api.PullRequest
@@ -131,14 +183,7 @@ func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters
 		}
 	}
 
-	fragment := fmt.Sprintf("fragment pr on PullRequest{%s}", api.PullRequestGraphQL(filters.Fields))
-	query := fragment + `
-		query PullRequestSearch(
-			$q: String!,
-			$limit: Int!,
-			$endCursor: String,
-		) {
-			search(query: $q, type: ISSUE, first: $limit, after: $endCursor) {
+	searchSelection := `
 				issueCount
 				nodes {
 					...pr
@@ -146,9 +191,23 @@ func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters
 				pageInfo {
 					hasNextPage
 					endCursor
-				}
+				}`
+	fragment := fmt.Sprintf("fragment pr on PullRequest{%s}", api.PullRequestGraphQL(filters.Fields))
+	if countOnly {
+		searchSelection = `issueCount`
+		fragment = ""
+	}
+
+	query := fragment + fmt.Sprintf(`
+		query PullRequestSearch(
+			$q: String!,
+			$limit: Int!,
+			$endCursor: String,
+		) {
+			search(query: $q, type: ISSUE, first: $limit, after: $endCursor) {
+				%s
 			}
-		}`
+		}`, searchSelection)
 
 	q := githubsearch.NewQuery()
 	q.SetType(githubsearch.PullRequest)
@@ -167,8 +226,8 @@ func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters
 	if filters.Author != "" {
 		q.AuthoredBy(filters.Author)
 	}
-	if filters.Assignee != "" {
-		q.AssignedTo(filters.Assignee)
+	for _, assignee := range filters.Assignee {
+		q.AssignedTo(assignee)
 	}
 	for _, label := range filters.Labels {
 		q.AddLabel(label)
@@ -176,6 +235,22 @@ func searchPullRequests(httpClient *http.Client, repo ghrepo.Interface, filters
 	if filters.BaseBranch != "" {
 		q.SetBaseBranch(filters.BaseBranch)
 	}
+	if filters.Milestone != "" {
+		q.InMilestone(filters.Milestone)
+	}
+	if filters.Created != "" {
+		q.CreatedRange(filters.Created)
+	}
+	if filters.Updated != "" {
+		q.UpdatedRange(filters.Updated)
+	}
+	if filters.Sort != "" {
+		direction := githubsearch.Desc
+		if filters.Order == "asc" {
+			direction = githubsearch.Asc
+		}
+		q.SortBy(githubsearch.SortField(filters.Sort), direction)
+	}
 
 	pageLimit := min(limit, 100)
 	variables := map[string]interface{}{
@@ -197,6 +272,13 @@ loop:
 		prData := data.Search
 		res.TotalCount = prData.IssueCount
 
+		if countOnly {
+			if res.TotalCount > searchResultsLimit {
+				res.SearchCapped = true
+			}
+			break loop
+		}
+
 		for _, pr := range prData.Nodes {
 			if _, exists := check[pr.Number]; exists && pr.Number > 0 {
 				continue
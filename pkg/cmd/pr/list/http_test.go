@@ -36,10 +36,12 @@ func Test_listPullRequests(t *testing.T) {
 					httpmock.GraphQL(`query PullRequestList\b`),
 					httpmock.GraphQLQuery(`{"data":{}}`, func(query string, vars map[string]interface{}) {
 						want := map[string]interface{}{
-							"owner": "OWNER",
-							"repo":  "REPO",
-							"state": []interface{}{"OPEN"},
-							"limit": float64(30),
+							"owner":            "OWNER",
+							"repo":             "REPO",
+							"state":            []interface{}{"OPEN"},
+							"limit":            float64(30),
+							"orderByField":     "CREATED_AT",
+							"orderByDirection": "DESC",
 						}
 						if !reflect.DeepEqual(vars, want) {
 							t.Errorf("got GraphQL variables %#v, want %#v", vars, want)
@@ -61,10 +63,12 @@ func Test_listPullRequests(t *testing.T) {
 					httpmock.GraphQL(`query PullRequestList\b`),
 					httpmock.GraphQLQuery(`{"data":{}}`, func(query string, vars map[string]interface{}) {
 						want := map[string]interface{}{
-							"owner": "OWNER",
-							"repo":  "REPO",
-							"state": []interface{}{"CLOSED", "MERGED"},
-							"limit": float64(30),
+							"owner":            "OWNER",
+							"repo":             "REPO",
+							"state":            []interface{}{"CLOSED", "MERGED"},
+							"limit":            float64(30),
+							"orderByField":     "CREATED_AT",
+							"orderByDirection": "DESC",
 						}
 						if !reflect.DeepEqual(vars, want) {
 							t.Errorf("got GraphQL variables %#v, want %#v", vars, want)
@@ -153,7 +157,7 @@ func Test_listPullRequests(t *testing.T) {
 			}
 			httpClient := &http.Client{Transport: reg}
 
-			_, err := listPullRequests(httpClient, tt.args.repo, tt.args.filters, tt.args.limit)
+			_, err := listPullRequests(httpClient, tt.args.repo, tt.args.filters, tt.args.limit, false)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("listPullRequests() error = %v, wantErr %v", err, tt.wantErr)
 				return
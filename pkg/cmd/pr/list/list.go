@@ -1,6 +1,7 @@
 package list
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -30,13 +31,20 @@ type ListOptions struct {
 	WebMode      bool
 	LimitResults int
 	Exporter     cmdutil.Exporter
+	Count        bool
 
 	State      string
 	BaseBranch string
 	Labels     []string
 	Author     string
-	Assignee   string
+	App        string
+	Assignee   []string
+	Milestone  string
 	Search     string
+	Sort       string
+	Direction  string
+	Created    string
+	Updated    string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -49,19 +57,38 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List and filter pull requests in this repository",
+		Long: heredoc.Doc(`
+			List and filter pull requests in this repository.
+
+			The --search flag's value is passed verbatim to GitHub's pull request
+			search. It is combined with any other flags, such as --label or
+			--author, using a logical AND.
+		`),
 		Example: heredoc.Doc(`
 			List PRs authored by you
 			$ gh pr list --author @me
 
+			List PRs authored by a bot
+			$ gh pr list --app dependabot
+
 			List PRs assigned to you
 			$ gh pr list --assignee @me
 
 			List PRs by label, combining multiple labels with AND
 			$ gh pr list --label bug --label "priority 1"
 
+			List PRs with a specific milestone
+			$ gh pr list --milestone "The big 1.0"
+
 			List PRs using search syntax
 			$ gh pr list --search "status:success review:required"
 
+			List PRs created in the last two weeks
+			$ gh pr list --created ">2w"
+
+			List PRs by popularity
+			$ gh pr list --sort popularity --search "is:open"
+
 			Open the list of PRs in a web browser
 			$ gh pr list --web
     	`),
@@ -74,6 +101,47 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: fmt.Errorf("invalid value for --limit: %v", opts.LimitResults)}
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--author` or `--app`",
+				opts.Author != "",
+				opts.App != "",
+			); err != nil {
+				return err
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--count`, `--web`, or `--json`",
+				opts.Count,
+				opts.WebMode,
+				opts.Exporter != nil,
+			); err != nil {
+				return err
+			}
+
+			switch opts.Sort {
+			case "", "created", "updated", "popularity", "long-running":
+			default:
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid sort: %s", opts.Sort)}
+			}
+
+			switch opts.Direction {
+			case "", "asc", "desc":
+			default:
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid direction: %s", opts.Direction)}
+			}
+
+			if (opts.Sort == "popularity" || opts.Sort == "long-running") && opts.Search == "" {
+				return &cmdutil.FlagError{Err: errors.New("--sort popularity and --sort long-running require --search")}
+			}
+
+			var err error
+			if opts.Created, err = shared.ParseDateRangeQualifier(opts.Created); err != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--created: %w", err)}
+			}
+			if opts.Updated, err = shared.ParseDateRangeQualifier(opts.Updated); err != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("--updated: %w", err)}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -87,8 +155,15 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "Filter by base branch")
 	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Filter by labels")
 	cmd.Flags().StringVarP(&opts.Author, "author", "A", "", "Filter by author")
-	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
+	cmd.Flags().StringVar(&opts.App, "app", "", "Filter by GitHub App author")
+	cmd.Flags().StringSliceVarP(&opts.Assignee, "assignee", "a", nil, "Filter by assignee; pass multiple times for pull requests assigned to any of them, or \"none\" for unassigned pull requests")
+	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone `number` or `title`, or \"none\" for pull requests with no milestone")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with `query`")
+	cmd.Flags().StringVar(&opts.Sort, "sort", "", "Sort fetched pull requests: {created|updated|popularity|long-running} (default: created)")
+	cmd.Flags().StringVar(&opts.Direction, "direction", "", "Direction of the sort: {asc|desc} (default: desc)")
+	cmd.Flags().StringVar(&opts.Created, "created", "", "Filter by created `date`, e.g. \"2021-03-01\", \"2021-03-01..2021-03-31\", or \">2w\"")
+	cmd.Flags().StringVar(&opts.Updated, "updated", "", "Filter by updated `date`, e.g. \"2021-03-01\", \"2021-03-01..2021-03-31\", or \">2w\"")
+	cmd.Flags().BoolVar(&opts.Count, "count", false, "Print the number of pull requests matching the filters rather than listing them")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	return cmd
@@ -116,19 +191,35 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
+	apiClient := api.NewClientFromHTTP(httpClient)
+	assignees, err := shared.NewMeReplacer(apiClient, baseRepo.RepoHost()).ReplaceSlice(opts.Assignee)
+	if err != nil {
+		return err
+	}
+
 	prState := strings.ToLower(opts.State)
 	if prState == "open" && shared.QueryHasStateClause(opts.Search) {
 		prState = ""
 	}
 
+	author := opts.Author
+	if opts.App != "" {
+		author = "app/" + opts.App
+	}
+
 	filters := shared.FilterOptions{
 		Entity:     "pr",
 		State:      prState,
-		Author:     opts.Author,
-		Assignee:   opts.Assignee,
+		Author:     author,
+		Assignee:   assignees,
 		Labels:     opts.Labels,
 		BaseBranch: opts.BaseBranch,
+		Milestone:  opts.Milestone,
 		Search:     opts.Search,
+		Sort:       opts.Sort,
+		Order:      opts.Direction,
+		Created:    opts.Created,
+		Updated:    opts.Updated,
 		Fields:     defaultFields,
 	}
 	if opts.Exporter != nil {
@@ -148,11 +239,25 @@ func listRun(opts *ListOptions) error {
 		return opts.Browser.Browse(openURL)
 	}
 
-	listResult, err := listPullRequests(httpClient, baseRepo, filters, opts.LimitResults)
+	limit := opts.LimitResults
+	if opts.Count {
+		limit = 1
+	}
+
+	listResult, err := listPullRequests(httpClient, baseRepo, filters, limit, opts.Count)
 	if err != nil {
 		return err
 	}
 
+	if listResult.SearchCapped {
+		fmt.Fprintln(opts.IO.ErrOut, "warning: this query uses the Search API which is capped at 1000 results maximum")
+	}
+
+	if opts.Count {
+		fmt.Fprintln(opts.IO.Out, listResult.TotalCount)
+		return nil
+	}
+
 	err = opts.IO.StartPager()
 	if err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
@@ -180,6 +285,7 @@ func listRun(opts *ListOptions) error {
 		table.AddField(pr.HeadLabel(), nil, cs.Cyan)
 		if !table.IsTTY() {
 			table.AddField(prStateWithDraft(&pr), nil, nil)
+			table.AddField(pr.URL, nil, nil)
 		}
 		table.EndRow()
 	}
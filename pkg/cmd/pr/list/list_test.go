@@ -95,9 +95,9 @@ func TestPRList_nontty(t *testing.T) {
 
 	assert.Equal(t, "", output.Stderr())
 
-	assert.Equal(t, `32	New feature	feature	DRAFT
-29	Fixed bad bug	hubot:bug-fix	OPEN
-28	Improve documentation	docs	MERGED
+	assert.Equal(t, `32	New feature	feature	DRAFT	https://github.com/monalisa/hello/pull/32
+29	Fixed bad bug	hubot:bug-fix	OPEN	https://github.com/monalisa/hello/pull/29
+28	Improve documentation	docs	MERGED	https://github.com/monalisa/hello/pull/28
 `, output.String())
 }
 
@@ -176,6 +176,65 @@ func TestPRList_filteringAssignee(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringApp(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open author:app/dependabot`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--app dependabot`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_filteringAppAndAuthorMutuallyExclusive(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `--app dependabot --author hubot`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "specify only one of `--author` or `--app`", err.Error())
+}
+
+func TestPRList_filteringMilestone(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open milestone:"1.0 Release"`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--milestone "1.0 Release"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_filteringMilestoneNone(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open no:milestone`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--milestone none`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestPRList_filteringAssigneeLabels(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -186,6 +245,71 @@ func TestPRList_filteringAssigneeLabels(t *testing.T) {
 	}
 }
 
+func TestPRList_filteringCreatedAndUpdated(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open created:>=2021-03-01 updated:2021-04-01..2021-04-30`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--created 2021-03-01 --updated 2021-04-01..2021-04-30`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_sortAndDirection(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestSearch\b`),
+		httpmock.GraphQLQuery(`{}`, func(_ string, params map[string]interface{}) {
+			assert.Equal(t, `repo:OWNER/REPO is:pr is:open sort:popularity-asc some search`, params["q"].(string))
+		}))
+
+	_, err := runCommand(http, true, `--sort popularity --direction asc --search "some search"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPRList_withInvalidSortFlag(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `--sort nonsense`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "invalid sort: nonsense", err.Error())
+}
+
+func TestPRList_withSortRequiringSearch(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `--sort long-running`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "--sort popularity and --sort long-running require --search", err.Error())
+}
+
+func TestPRList_withInvalidCreatedFlag(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, `--created nonsense`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, `--created: invalid date "nonsense"; examples: "2021-03-01", "2021-03-01..2021-03-31", ">=2021-03-01", ">2w", "<=3mo"`, err.Error())
+}
+
 func TestPRList_withInvalidLimitFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -196,6 +320,36 @@ func TestPRList_withInvalidLimitFlag(t *testing.T) {
 	}
 }
 
+func TestPRList_count(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(
+		httpmock.GraphQL(`query PullRequestList\b`),
+		httpmock.StringResponse(`
+			{ "data": { "repository": { "pullRequests": { "totalCount": 23 } } } }
+			`))
+
+	output, err := runCommand(http, true, "--count")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "", output.Stderr())
+	assert.Equal(t, "23\n", output.String())
+}
+
+func TestPRList_countWithJSON(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	_, err := runCommand(http, true, "--count --json number")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	assert.Equal(t, "specify only one of `--count`, `--web`, or `--json`", err.Error())
+}
+
 func TestPRList_web(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -2,6 +2,7 @@ package merge
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,10 @@ import (
 	"github.com/shurcooL/graphql"
 )
 
+// errHeadChanged is returned by mergePullRequest when --match-head-commit was
+// specified and the pull request's head branch has since moved.
+var errHeadChanged = errors.New("head branch changed")
+
 type PullRequestMergeMethod int
 
 const (
@@ -28,6 +33,7 @@ type mergePayload struct {
 	setCommitSubject bool
 	commitBody       string
 	setCommitBody    bool
+	matchHeadCommit  string
 }
 
 // TODO: drop after githubv4 gets updated
@@ -60,6 +66,10 @@ func mergePullRequest(client *http.Client, payload mergePayload) error {
 		commitBody := githubv4.String(payload.commitBody)
 		input.CommitBody = &commitBody
 	}
+	if payload.matchHeadCommit != "" {
+		expectedHeadOid := githubv4.GitObjectID(payload.matchHeadCommit)
+		input.ExpectedHeadOid = &expectedHeadOid
+	}
 
 	variables := map[string]interface{}{
 		"input": input,
@@ -67,6 +77,7 @@ func mergePullRequest(client *http.Client, payload mergePayload) error {
 
 	gql := graphql.NewClient(ghinstance.GraphQLEndpoint(payload.repo.RepoHost()), client)
 
+	var err error
 	if payload.auto {
 		var mutation struct {
 			EnablePullRequestAutoMerge struct {
@@ -74,15 +85,20 @@ func mergePullRequest(client *http.Client, payload mergePayload) error {
 			} `graphql:"enablePullRequestAutoMerge(input: $input)"`
 		}
 		variables["input"] = EnablePullRequestAutoMergeInput{input}
-		return gql.MutateNamed(context.Background(), "PullRequestAutoMerge", &mutation, variables)
+		err = gql.MutateNamed(context.Background(), "PullRequestAutoMerge", &mutation, variables)
+	} else {
+		var mutation struct {
+			MergePullRequest struct {
+				ClientMutationId string
+			} `graphql:"mergePullRequest(input: $input)"`
+		}
+		err = gql.MutateNamed(context.Background(), "PullRequestMerge", &mutation, variables)
 	}
 
-	var mutation struct {
-		MergePullRequest struct {
-			ClientMutationId string
-		} `graphql:"mergePullRequest(input: $input)"`
+	if err != nil && strings.Contains(err.Error(), "Head branch was modified") {
+		return errHeadChanged
 	}
-	return gql.MutateNamed(context.Background(), "PullRequestMerge", &mutation, variables)
+	return err
 }
 
 func disableAutoMerge(client *http.Client, repo ghrepo.Interface, prID string) error {
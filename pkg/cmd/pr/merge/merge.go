@@ -40,6 +40,11 @@ type MergeOptions struct {
 	BodySet bool
 	Editor  editor
 
+	Subject    string
+	SubjectSet bool
+
+	MatchHeadCommit string
+
 	IsDeleteBranchIndicated bool
 	CanDeleteLocalBranch    bool
 	InteractiveMode         bool
@@ -117,6 +122,9 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 				return err
 			}
 			if bodyProvided || bodyFileProvided {
+				if flagRebase {
+					return &cmdutil.FlagError{Err: errors.New("--body or --body-file is not supported with --rebase")}
+				}
 				opts.BodySet = true
 				if bodyFileProvided {
 					b, err := cmdutil.ReadFile(bodyFile, opts.IO.In)
@@ -136,7 +144,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			if runF != nil {
 				return runF(opts)
 			}
-			return mergeRun(opts)
+			return Run(opts)
 		},
 	}
 
@@ -148,10 +156,11 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
 	cmd.Flags().BoolVar(&opts.AutoMergeEnable, "auto", false, "Automatically merge only after necessary requirements are met")
 	cmd.Flags().BoolVar(&opts.AutoMergeDisable, "disable-auto", false, "Disable auto-merge for this pull request")
+	cmd.Flags().StringVar(&opts.MatchHeadCommit, "match-head-commit", "", "Commit `SHA` that the pull request head must match to allow merge")
 	return cmd
 }
 
-func mergeRun(opts *MergeOptions) error {
+func Run(opts *MergeOptions) error {
 	cs := opts.IO.ColorScheme()
 
 	findOptions := shared.FindOptions{
@@ -202,12 +211,15 @@ func mergeRun(opts *MergeOptions) error {
 	isPRAlreadyMerged := pr.State == "MERGED"
 	if !isPRAlreadyMerged {
 		payload := mergePayload{
-			repo:          baseRepo,
-			pullRequestID: pr.ID,
-			method:        opts.MergeMethod,
-			auto:          opts.AutoMergeEnable,
-			commitBody:    opts.Body,
-			setCommitBody: opts.BodySet,
+			repo:             baseRepo,
+			pullRequestID:    pr.ID,
+			method:           opts.MergeMethod,
+			auto:             opts.AutoMergeEnable,
+			commitBody:       opts.Body,
+			setCommitBody:    opts.BodySet,
+			commitSubject:    opts.Subject,
+			setCommitSubject: opts.SubjectSet,
+			matchHeadCommit:  opts.MatchHeadCommit,
 		}
 
 		if opts.InteractiveMode {
@@ -258,6 +270,10 @@ func mergeRun(opts *MergeOptions) error {
 
 		err = mergePullRequest(httpClient, payload)
 		if err != nil {
+			if errors.Is(err, errHeadChanged) {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Pull request #%d's head branch changed after --match-head-commit was provided; re-check the pull request and try again\n", cs.Red("!"), pr.Number)
+				return cmdutil.SilentError
+			}
 			return err
 		}
 
@@ -121,6 +121,12 @@ func Test_NewCmdMerge(t *testing.T) {
 			isTTY:   true,
 			wantErr: "specify only one of `--body` or `--body-file`",
 		},
+		{
+			name:    "body-file and rebase flags",
+			args:    fmt.Sprintf("123 --rebase --body-file '%s'", tmpFile),
+			isTTY:   true,
+			wantErr: "--body or --body-file is not supported with --rebase",
+		},
 		{
 			name:    "no argument with --repo override",
 			args:    "-R owner/repo",
@@ -323,6 +329,75 @@ func TestPrMerge_nontty(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func TestPrMerge_matchHeadCommit(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"1",
+		&api.PullRequest{
+			ID:     "THE-ID",
+			Number: 1,
+			State:  "OPEN",
+			Title:  "The title of the PR",
+		},
+		baseRepo("OWNER", "REPO", "master"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "abc123", input["expectedHeadOid"].(string))
+		}))
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	output, err := runCommand(http, "master", true, "pr merge 1 --merge --match-head-commit abc123")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	r := regexp.MustCompile(`Merged pull request #1 \(The title of the PR\)`)
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
+func TestPrMerge_matchHeadCommitChanged(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"1",
+		&api.PullRequest{
+			ID:     "THE-ID",
+			Number: 1,
+			State:  "OPEN",
+			Title:  "The title of the PR",
+		},
+		baseRepo("OWNER", "REPO", "master"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.StringResponse(`{ "errors": [ { "message": "Head branch was modified. Review and try the merge again." } ] }`))
+
+	_, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	output, err := runCommand(http, "master", true, "pr merge 1 --merge --match-head-commit abc123")
+	if err != cmdutil.SilentError {
+		t.Fatalf("expected SilentError, got %v", err)
+	}
+
+	r := regexp.MustCompile(`Pull request #1's head branch changed`)
+	if !r.MatchString(output.Stderr()) {
+		t.Fatalf("output did not match regexp /%s/\n> output\n%q\n", r, output.Stderr())
+	}
+}
+
 func TestPrMerge_withRepoFlag(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -849,7 +924,7 @@ func TestPRMerge_interactiveSquashEditCommitMsg(t *testing.T) {
 	as.StubOne("Edit commit message") // Confirm submit survey
 	as.StubOne("Submit")              // Confirm submit survey
 
-	err := mergeRun(&MergeOptions{
+	err := Run(&MergeOptions{
 		IO:     io,
 		Editor: testEditor{},
 		HttpClient: func() (*http.Client, error) {
@@ -937,7 +1012,7 @@ func TestMergeRun_autoMerge(t *testing.T) {
 	_, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 
-	err := mergeRun(&MergeOptions{
+	err := Run(&MergeOptions{
 		IO: io,
 		HttpClient: func() (*http.Client, error) {
 			return &http.Client{Transport: tr}, nil
@@ -973,7 +1048,7 @@ func TestMergeRun_disableAutoMerge(t *testing.T) {
 	_, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 
-	err := mergeRun(&MergeOptions{
+	err := Run(&MergeOptions{
 		IO: io,
 		HttpClient: func() (*http.Client, error) {
 			return &http.Client{Transport: tr}, nil
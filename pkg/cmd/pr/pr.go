@@ -14,6 +14,8 @@ import (
 	cmdReady "github.com/cli/cli/pkg/cmd/pr/ready"
 	cmdReopen "github.com/cli/cli/pkg/cmd/pr/reopen"
 	cmdReview "github.com/cli/cli/pkg/cmd/pr/review"
+	cmdReviewers "github.com/cli/cli/pkg/cmd/pr/reviewers"
+	cmdSquash "github.com/cli/cli/pkg/cmd/pr/squash"
 	cmdStatus "github.com/cli/cli/pkg/cmd/pr/status"
 	cmdView "github.com/cli/cli/pkg/cmd/pr/view"
 	"github.com/cli/cli/pkg/cmdutil"
@@ -57,6 +59,8 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdChecks.NewCmdChecks(f, nil))
 	cmd.AddCommand(cmdComment.NewCmdComment(f, nil))
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f, nil))
+	cmd.AddCommand(cmdReviewers.NewCmdReviewers(f, nil))
+	cmd.AddCommand(cmdSquash.NewCmdSquash(f, nil))
 
 	return cmd
 }
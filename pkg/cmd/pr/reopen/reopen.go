@@ -1,6 +1,7 @@
 package reopen
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -17,7 +18,7 @@ type ReopenOptions struct {
 
 	Finder shared.PRFinder
 
-	SelectorArg string
+	SelectorArgs []string
 }
 
 func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Command {
@@ -27,15 +28,12 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 	}
 
 	cmd := &cobra.Command{
-		Use:   "reopen {<number> | <url> | <branch>}",
+		Use:   "reopen [<number> | <url> | <branch>] ...",
 		Short: "Reopen a pull request",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
-
-			if len(args) > 0 {
-				opts.SelectorArg = args[0]
-			}
+			opts.SelectorArgs = args
 
 			if runF != nil {
 				return runF(opts)
@@ -48,10 +46,28 @@ func NewCmdReopen(f *cmdutil.Factory, runF func(*ReopenOptions) error) *cobra.Co
 }
 
 func reopenRun(opts *ReopenOptions) error {
+	selectors := opts.SelectorArgs
+	if len(selectors) == 0 {
+		selectors = []string{""}
+	}
+
+	var reopenErr error
+	for _, selector := range selectors {
+		if err := reopenOne(opts, selector); err != nil {
+			if !errors.Is(err, cmdutil.SilentError) {
+				fmt.Fprintf(opts.IO.ErrOut, "%s\n", err)
+			}
+			reopenErr = cmdutil.SilentError
+		}
+	}
+	return reopenErr
+}
+
+func reopenOne(opts *ReopenOptions, selector string) error {
 	cs := opts.IO.ColorScheme()
 
 	findOptions := shared.FindOptions{
-		Selector: opts.SelectorArg,
+		Selector: selector,
 		Fields:   []string{"id", "number", "state", "title"},
 	}
 	pr, baseRepo, err := opts.Finder.Find(findOptions)
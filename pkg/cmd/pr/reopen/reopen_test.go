@@ -2,10 +2,12 @@ package reopen
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
@@ -74,6 +76,31 @@ func TestPRReopen(t *testing.T) {
 	assert.Equal(t, "✓ Reopened pull request #123 (The title of the PR)\n", output.Stderr())
 }
 
+func TestPRReopen_noArgs(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder("", &api.PullRequest{
+		ID:     "THE-ID",
+		Number: 123,
+		State:  "CLOSED",
+		Title:  "The title of the PR",
+	}, ghrepo.New("OWNER", "REPO"))
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestReopen\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["pullRequestId"], "THE-ID")
+			}),
+	)
+
+	output, err := runCommand(http, true, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Reopened pull request #123 (The title of the PR)\n", output.Stderr())
+}
+
 func TestPRReopen_alreadyOpen(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -107,3 +134,54 @@ func TestPRReopen_alreadyMerged(t *testing.T) {
 	assert.Equal(t, "", output.String())
 	assert.Equal(t, "X Pull request #123 (The title of the PR) can't be reopened because it was already merged\n", output.Stderr())
 }
+
+// multiFinder resolves PRs by selector for tests that reopen more than one PR in a single invocation.
+type multiFinder struct {
+	prs  map[string]*api.PullRequest
+	repo ghrepo.Interface
+}
+
+func (f *multiFinder) Find(opts shared.FindOptions) (*api.PullRequest, ghrepo.Interface, error) {
+	pr, ok := f.prs[opts.Selector]
+	if !ok {
+		return nil, nil, fmt.Errorf("no pull request found for %q", opts.Selector)
+	}
+	return pr, f.repo, nil
+}
+
+func TestPRReopen_multipleSelectors(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestReopen\b`),
+		httpmock.GraphQLMutation(`{"id": "THE-ID"}`, func(inputs map[string]interface{}) {}),
+	)
+
+	io, _, _, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &ReopenOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		SelectorArgs: []string{"123", "124"},
+		Finder: &multiFinder{
+			repo: ghrepo.New("OWNER", "REPO"),
+			prs: map[string]*api.PullRequest{
+				"123": {ID: "THE-ID", Number: 123, State: "CLOSED", Title: "First PR"},
+				"124": {ID: "THE-ID", Number: 124, State: "OPEN", Title: "Second PR"},
+			},
+		},
+	}
+
+	err := reopenRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		✓ Reopened pull request #123 (First PR)
+		! Pull request #124 (Second PR) is already open
+	`), stderr.String())
+}
@@ -0,0 +1,54 @@
+package review
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/githubsearch"
+)
+
+// searchReviewablePullRequests resolves the open pull requests in repo that match query, for use
+// with the bulk --search mode of `gh pr review`.
+func searchReviewablePullRequests(httpClient *http.Client, repo ghrepo.Interface, query string) ([]*api.PullRequest, error) {
+	type response struct {
+		Search struct {
+			Nodes []api.PullRequest
+		}
+	}
+
+	fragment := fmt.Sprintf("fragment pr on PullRequest{%s}", api.PullRequestGraphQL([]string{"id", "number"}))
+	gqlQuery := fragment + `
+		query PullRequestReviewSearch($q: String!, $limit: Int!) {
+			search(query: $q, type: ISSUE, first: $limit) {
+				nodes {
+					...pr
+				}
+			}
+		}`
+
+	q := githubsearch.NewQuery()
+	q.SetType(githubsearch.PullRequest)
+	q.InRepository(ghrepo.FullName(repo))
+	q.SetState(githubsearch.Open)
+	q.AddQuery(query)
+
+	variables := map[string]interface{}{
+		"q":     q.String(),
+		"limit": 100,
+	}
+
+	client := api.NewClientFromHTTP(httpClient)
+	var data response
+	err := client.GraphQL(repo.RepoHost(), gqlQuery, variables, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*api.PullRequest, len(data.Search.Nodes))
+	for i := range data.Search.Nodes {
+		prs[i] = &data.Search.Nodes[i]
+	}
+	return prs, nil
+}
@@ -3,12 +3,14 @@ package review
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmd/pr/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
@@ -22,10 +24,13 @@ type ReviewOptions struct {
 	HttpClient func() (*http.Client, error)
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
 
 	Finder shared.PRFinder
 
 	SelectorArg     string
+	SelectorArgs    []string
+	Search          string
 	InteractiveMode bool
 	ReviewType      api.PullRequestReviewState
 	Body            string
@@ -47,12 +52,17 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	var bodyFile string
 
 	cmd := &cobra.Command{
-		Use:   "review [<number> | <url> | <branch>]",
+		Use:   "review [<number> | <url> | <branch>] ...",
 		Short: "Add a review to a pull request",
 		Long: heredoc.Doc(`
 			Add a review to a pull request.
 
 			Without an argument, the pull request that belongs to the current branch is reviewed.
+
+			To review more than one pull request at once, pass multiple selectors or use
+			--search to resolve a batch of pull requests from a search query. In that mode,
+			the same review is submitted to every matching pull request, and a failure on
+			one pull request does not stop the others from being reviewed.
 		`),
 		Example: heredoc.Doc(`
 			# approve the pull request of the current branch
@@ -66,18 +76,35 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 
 			# request changes on a specific pull request
 			$ gh pr review 123 -r -b "needs more ASCII art"
+
+			# approve a batch of pull requests
+			$ gh pr review --approve 12 15 18
+
+			# approve every open pull request authored by dependabot
+			$ gh pr review --approve --search "author:app/dependabot"
 		`),
-		Args: cobra.MaximumNArgs(1),
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
+			opts.BaseRepo = f.BaseRepo
 
-			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 && opts.Search == "" {
 				return &cmdutil.FlagError{Err: errors.New("argument required when using the --repo flag")}
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify pull request selectors or `--search`, not both",
+				len(args) > 0,
+				opts.Search != "",
+			); err != nil {
+				return err
+			}
+
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
+				opts.SelectorArgs = args
 			}
+			bulkMode := len(opts.SelectorArgs) > 1 || opts.Search != ""
 
 			bodyProvided := cmd.Flags().Changed("body")
 			bodyFileProvided := bodyFile != ""
@@ -118,6 +145,9 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 			}
 
 			if found == 0 && opts.Body == "" {
+				if bulkMode {
+					return &cmdutil.FlagError{Err: errors.New("--approve, --request-changes, or --comment required when reviewing multiple pull requests")}
+				}
 				if !opts.IO.CanPrompt() {
 					return &cmdutil.FlagError{Err: errors.New("--approve, --request-changes, or --comment required when not running interactively")}
 				}
@@ -140,11 +170,16 @@ func NewCmdReview(f *cmdutil.Factory, runF func(*ReviewOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&flagComment, "comment", "c", false, "Comment on a pull request")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Specify the body of a review")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file`")
+	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Review the open pull requests matching `query`; only the first 100 results are reviewed")
 
 	return cmd
 }
 
 func reviewRun(opts *ReviewOptions) error {
+	if len(opts.SelectorArgs) > 1 || opts.Search != "" {
+		return reviewRunBulk(opts)
+	}
+
 	findOptions := shared.FindOptions{
 		Selector: opts.SelectorArg,
 		Fields:   []string{"id", "number"},
@@ -191,17 +226,79 @@ func reviewRun(opts *ReviewOptions) error {
 	}
 
 	cs := opts.IO.ColorScheme()
+	printReviewResult(opts.IO.ErrOut, cs, pr.Number, reviewData.State)
+
+	return nil
+}
+
+// reviewRunBulk submits the same review to every pull request resolved from opts.SelectorArgs
+// or opts.Search, continuing on to the rest of the batch when an individual review fails.
+func reviewRunBulk(opts *ReviewOptions) error {
+	reviewData := &api.PullRequestReviewInput{
+		State: opts.ReviewType,
+		Body:  opts.Body,
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	var prs []*api.PullRequest
+	var baseRepo ghrepo.Interface
+	if opts.Search != "" {
+		baseRepo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		prs, err = searchReviewablePullRequests(httpClient, baseRepo, opts.Search)
+		if err != nil {
+			return fmt.Errorf("failed to search for pull requests: %w", err)
+		}
+		if len(prs) == 0 {
+			fmt.Fprintf(opts.IO.ErrOut, "no open pull requests matched %q\n", opts.Search)
+			return nil
+		}
+	} else {
+		for _, selector := range opts.SelectorArgs {
+			pr, repo, err := opts.Finder.Find(shared.FindOptions{
+				Selector: selector,
+				Fields:   []string{"id", "number"},
+			})
+			if err != nil {
+				return err
+			}
+			prs = append(prs, pr)
+			baseRepo = repo
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	var reviewErr error
+	for _, pr := range prs {
+		if err := api.AddReview(apiClient, baseRepo, pr, reviewData); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to review pull request #%d: %s\n", cs.FailureIcon(), pr.Number, err)
+			reviewErr = cmdutil.SilentError
+			continue
+		}
+		if opts.IO.IsStdoutTTY() && opts.IO.IsStderrTTY() {
+			printReviewResult(opts.IO.ErrOut, cs, pr.Number, reviewData.State)
+		}
+	}
+
+	return reviewErr
+}
 
-	switch reviewData.State {
+func printReviewResult(w io.Writer, cs *iostreams.ColorScheme, prNumber int, state api.PullRequestReviewState) {
+	switch state {
 	case api.ReviewComment:
-		fmt.Fprintf(opts.IO.ErrOut, "%s Reviewed pull request #%d\n", cs.Gray("-"), pr.Number)
+		fmt.Fprintf(w, "%s Reviewed pull request #%d\n", cs.Gray("-"), prNumber)
 	case api.ReviewApprove:
-		fmt.Fprintf(opts.IO.ErrOut, "%s Approved pull request #%d\n", cs.SuccessIcon(), pr.Number)
+		fmt.Fprintf(w, "%s Approved pull request #%d\n", cs.SuccessIcon(), prNumber)
 	case api.ReviewRequestChanges:
-		fmt.Fprintf(opts.IO.ErrOut, "%s Requested changes to pull request #%d\n", cs.Red("+"), pr.Number)
+		fmt.Fprintf(w, "%s Requested changes to pull request #%d\n", cs.Red("+"), prNumber)
 	}
-
-	return nil
 }
 
 func reviewSurvey(io *iostreams.IOStreams, editorCommand string) (*api.PullRequestReviewInput, error) {
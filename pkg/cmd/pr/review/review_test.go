@@ -179,6 +179,9 @@ func runCommand(rt http.RoundTripper, remotes context.Remotes, isTTY bool, cli s
 		Config: func() (config.Config, error) {
 			return config.NewBlankConfig(), nil
 		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
 	}
 
 	cmd := NewCmdReview(factory, nil)
@@ -377,3 +380,104 @@ func TestPRReview_interactive_blank_approve(t *testing.T) {
 	assert.Equal(t, "", output.String())
 	assert.Equal(t, "✓ Approved pull request #123\n", output.Stderr())
 }
+
+// multiFinder resolves PRs by selector for tests that review more than one PR in a single invocation.
+type multiFinder struct {
+	prs map[string]*api.PullRequest
+}
+
+func (f *multiFinder) Find(opts shared.FindOptions) (*api.PullRequest, ghrepo.Interface, error) {
+	pr, ok := f.prs[opts.Selector]
+	if !ok {
+		return nil, nil, fmt.Errorf("no pull request found for %q", opts.Selector)
+	}
+	return pr, ghrepo.New("OWNER", "REPO"), nil
+}
+
+func TestPRReview_multipleSelectors(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestReviewAdd\b`),
+		httpmock.GraphQLMutation(`{"data": {} }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "THE-ID-12", inputs["pullRequestId"])
+			}),
+	)
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestReviewAdd\b`),
+		httpmock.StatusStringResponse(422, `{"message": "Unprocessable Entity"}`),
+	)
+
+	io, _, stdout, stderr := iostreams.Test()
+
+	opts := &ReviewOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Finder: &multiFinder{
+			prs: map[string]*api.PullRequest{
+				"12": {ID: "THE-ID-12", Number: 12},
+				"15": {ID: "THE-ID-15", Number: 15},
+			},
+		},
+		SelectorArgs: []string{"12", "15"},
+		ReviewType:   api.ReviewApprove,
+	}
+
+	err := reviewRun(opts)
+	assert.Error(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Contains(t, stderr.String(), "Failed to review pull request #15")
+}
+
+func TestPRReview_search(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query PullRequestReviewSearch\b`),
+		httpmock.StringResponse(`
+			{ "data": { "search": { "nodes": [
+				{ "id": "THE-ID-12", "number": 12 },
+				{ "id": "THE-ID-15", "number": 15 }
+			] } } }`),
+	)
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestReviewAdd\b`),
+		httpmock.GraphQLMutation(`{"data": {} }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "APPROVE", inputs["event"])
+			}),
+	)
+	reg.Register(
+		httpmock.GraphQL(`mutation PullRequestReviewAdd\b`),
+		httpmock.GraphQLMutation(`{"data": {} }`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, "APPROVE", inputs["event"])
+			}),
+	)
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	opts := &ReviewOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Search:     "author:app/dependabot",
+		ReviewType: api.ReviewApprove,
+	}
+
+	err := reviewRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Approved pull request #12\n✓ Approved pull request #15\n", stderr.String())
+}
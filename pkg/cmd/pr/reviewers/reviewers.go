@@ -0,0 +1,133 @@
+package reviewers
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/text"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ReviewersOptions struct {
+	IO *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+	Exporter    cmdutil.Exporter
+}
+
+var reviewerFields = []string{
+	"login",
+	"state",
+	"authorAssociation",
+	"submittedAt",
+	"body",
+}
+
+func NewCmdReviewers(f *cmdutil.Factory, runF func(*ReviewersOptions) error) *cobra.Command {
+	opts := &ReviewersOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reviewers [<number> | <url> | <branch>]",
+		Short: "List the reviewers of a pull request and their review states",
+		Long: heredoc.Doc(`
+			List the reviewers of a pull request and their most recent review state.
+
+			Without an argument, the pull request that belongs to the current branch
+			is selected.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return reviewersRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, reviewerFields)
+
+	return cmd
+}
+
+func reviewersRun(opts *ReviewersOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "latestReviews"},
+	}
+	pr, _, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO.Out, pr.LatestReviews.Nodes, opts.IO.ColorEnabled())
+	}
+
+	if len(pr.LatestReviews.Nodes) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "no reviews found for this pull request")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	isTerminal := opts.IO.IsStdoutTTY()
+
+	tp := utils.NewTablePrinter(opts.IO)
+	for _, review := range pr.LatestReviews.Nodes {
+		login := review.Author.Login
+		if login == "" {
+			login = "ghost"
+		}
+
+		if isTerminal {
+			tp.AddField(login, nil, cs.Bold)
+		} else {
+			tp.AddField(login, nil, nil)
+		}
+
+		tp.AddField(review.State, nil, reviewStateColorFunc(cs, review.State))
+
+		submittedAt := ""
+		if review.SubmittedAt != nil {
+			submittedAt = review.SubmittedAt.Format("2006-01-02T15:04:05Z")
+		}
+		tp.AddField(submittedAt, nil, nil)
+
+		body := text.ReplaceExcessiveWhitespace(review.Body)
+		if isTerminal {
+			body = text.Truncate(50, body)
+		}
+		tp.AddField(body, nil, nil)
+
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func reviewStateColorFunc(cs *iostreams.ColorScheme, state string) func(string) string {
+	switch state {
+	case "APPROVED":
+		return cs.Green
+	case "CHANGES_REQUESTED":
+		return cs.Red
+	case "PENDING":
+		return cs.Yellow
+	default:
+		return func(s string) string { return s }
+	}
+}
@@ -0,0 +1,116 @@
+package reviewers
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdReviewers(t *testing.T) {
+	tests := []struct {
+		name  string
+		cli   string
+		wants ReviewersOptions
+	}{
+		{
+			name:  "no arguments",
+			cli:   "",
+			wants: ReviewersOptions{},
+		},
+		{
+			name: "pr argument",
+			cli:  "1234",
+			wants: ReviewersOptions{
+				SelectorArg: "1234",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			require.NoError(t, err)
+
+			var gotOpts *ReviewersOptions
+			cmd := NewCmdReviewers(f, func(opts *ReviewersOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wants.SelectorArg, gotOpts.SelectorArg)
+		})
+	}
+}
+
+func Test_reviewersRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		prJSON  string
+		nontty  bool
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "no reviews",
+			prJSON:  `{ "number": 123, "latestReviews": { "nodes": [] } }`,
+			wantErr: "",
+			wantOut: "",
+		},
+		{
+			name: "some reviews",
+			prJSON: `{ "number": 123, "latestReviews": { "nodes": [
+				{ "author": { "login": "monalisa" }, "state": "APPROVED", "submittedAt": "2020-08-31T15:44:24Z", "body": "Looks good!" },
+				{ "author": { "login": "hubot" }, "state": "CHANGES_REQUESTED", "submittedAt": "2020-08-31T16:44:24Z", "body": "Needs work" }
+			] } }`,
+			nontty:  true,
+			wantOut: "monalisa\tAPPROVED\t2020-08-31T15:44:24Z\tLooks good!\nhubot\tCHANGES_REQUESTED\t2020-08-31T16:44:24Z\tNeeds work\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(!tt.nontty)
+
+			var response *api.PullRequest
+			dec := json.NewDecoder(bytes.NewBufferString(tt.prJSON))
+			require.NoError(t, dec.Decode(&response))
+
+			opts := &ReviewersOptions{
+				IO:          ios,
+				SelectorArg: "123",
+				Finder:      shared.NewMockFinder("123", response, ghrepo.New("OWNER", "REPO")),
+			}
+
+			err := reviewersRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
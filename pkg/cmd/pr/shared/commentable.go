@@ -42,6 +42,7 @@ type CommentableOptions struct {
 	Interactive           bool
 	InputType             InputType
 	Body                  string
+	EditLast              bool
 }
 
 func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
@@ -76,6 +77,10 @@ func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
 		return &cmdutil.FlagError{Err: fmt.Errorf("specify only one of `--body`, `--body-file`, `--editor`, or `--web`")}
 	}
 
+	if opts.EditLast && opts.InputType == InputTypeWeb {
+		return &cmdutil.FlagError{Err: fmt.Errorf("`--edit-last` cannot be combined with `--web`")}
+	}
+
 	return nil
 }
 
@@ -120,6 +125,26 @@ func CommentableRun(opts *CommentableOptions) error {
 		return err
 	}
 	apiClient := api.NewClientFromHTTP(httpClient)
+
+	if opts.EditLast {
+		login, err := api.CurrentLoginName(apiClient, repo.RepoHost())
+		if err != nil {
+			return err
+		}
+		lastComment, err := api.LastComment(apiClient, repo.RepoHost(), commentable.Identifier(), login)
+		if err != nil {
+			return err
+		}
+		if lastComment != nil {
+			url, err := api.CommentUpdate(apiClient, repo.RepoHost(), lastComment.ID, api.CommentCreateInput{Body: opts.Body})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(opts.IO.Out, url)
+			return nil
+		}
+	}
+
 	params := api.CommentCreateInput{Body: opts.Body, SubjectId: commentable.Identifier()}
 	url, err := api.CommentCreate(apiClient, repo.RepoHost(), params)
 	if err != nil {
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	dateQualifierPattern     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	relativeDurationPattern  = regexp.MustCompile(`^(\d+)(d|w|mo|y)$`)
+	dateRangeOperatorPattern = regexp.MustCompile(`^(>=|<=|>|<)`)
+)
+
+const dateRangeExamples = `examples: "2021-03-01", "2021-03-01..2021-03-31", ">=2021-03-01", ">2w", "<=3mo"`
+
+// ParseDateRangeQualifier validates and normalizes the value of a --created/--updated flag
+// into the qualifier value expected after "created:" or "updated:" in a search query. It
+// accepts an absolute date ("2021-03-01", treated as "on or after" that date when no
+// operator is given), a date range ("2021-03-01..2021-03-31"), or a relative duration
+// measured from now (">2w", "<=3mo", "1y" meaning "1 year ago or more recently").
+func ParseDateRangeQualifier(input string) (string, error) {
+	return parseDateRangeQualifier(input, time.Now())
+}
+
+func parseDateRangeQualifier(input string, now time.Time) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	if strings.Contains(input, "..") {
+		parts := strings.SplitN(input, "..", 2)
+		if len(parts) != 2 || !dateQualifierPattern.MatchString(parts[0]) || !dateQualifierPattern.MatchString(parts[1]) {
+			return "", fmt.Errorf("invalid date range %q; ranges must use two YYYY-MM-DD dates, %s", input, dateRangeExamples)
+		}
+		return input, nil
+	}
+
+	operator := dateRangeOperatorPattern.FindString(input)
+	value := strings.TrimPrefix(input, operator)
+
+	if dateQualifierPattern.MatchString(value) {
+		if operator == "" {
+			operator = ">="
+		}
+		return operator + value, nil
+	}
+
+	if m := relativeDurationPattern.FindStringSubmatch(value); m != nil {
+		amount, err := strconv.Atoi(m[1])
+		if err != nil {
+			return "", fmt.Errorf("invalid duration %q; %s", input, dateRangeExamples)
+		}
+		if operator == "" {
+			operator = ">="
+		}
+		return operator + subtractDuration(now, amount, m[2]).Format("2006-01-02"), nil
+	}
+
+	return "", fmt.Errorf("invalid date %q; %s", input, dateRangeExamples)
+}
+
+func subtractDuration(t time.Time, amount int, unit string) time.Time {
+	switch unit {
+	case "d":
+		return t.AddDate(0, 0, -amount)
+	case "w":
+		return t.AddDate(0, 0, -amount*7)
+	case "mo":
+		return subtractMonths(t, amount)
+	case "y":
+		return subtractMonths(t, amount*12)
+	default:
+		return t
+	}
+}
+
+// subtractMonths subtracts the given number of months from t, clamping the result to the
+// last day of the target month instead of overflowing into the following month the way
+// time.Time.AddDate does (e.g. one month before March 31st is February 28th, not March 3rd).
+func subtractMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+
+	totalMonths := int(month) - 1 - months
+	year += totalMonths / 12
+	monthIndex := totalMonths % 12
+	if monthIndex < 0 {
+		monthIndex += 12
+		year--
+	}
+	resultMonth := time.Month(monthIndex + 1)
+
+	if lastDay := daysInMonth(year, resultMonth); day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, resultMonth, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,127 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseDateRangeQualifier(t *testing.T) {
+	now := time.Date(2021, time.March, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "blank",
+			input: "",
+			want:  "",
+		},
+		{
+			name:  "absolute date defaults to on-or-after",
+			input: "2021-03-01",
+			want:  ">=2021-03-01",
+		},
+		{
+			name:  "absolute date with explicit operator",
+			input: ">2021-03-01",
+			want:  ">2021-03-01",
+		},
+		{
+			name:  "date range",
+			input: "2021-03-01..2021-03-31",
+			want:  "2021-03-01..2021-03-31",
+		},
+		{
+			name:    "invalid date range",
+			input:   "2021-03-01..not-a-date",
+			wantErr: `invalid date range "2021-03-01..not-a-date"; ranges must use two YYYY-MM-DD dates, examples: "2021-03-01", "2021-03-01..2021-03-31", ">=2021-03-01", ">2w", "<=3mo"`,
+		},
+		{
+			name:  "relative days",
+			input: ">10d",
+			want:  ">2021-03-21",
+		},
+		{
+			name:  "relative weeks defaults to on-or-after",
+			input: "2w",
+			want:  ">=2021-03-17",
+		},
+		{
+			name:  "relative months across a month-end boundary",
+			input: ">1mo",
+			want:  ">2021-02-28",
+		},
+		{
+			name:  "relative months, two months back lands on the last day of january",
+			input: "<=2mo",
+			want:  "<=2021-01-31",
+		},
+		{
+			name:  "relative years",
+			input: ">=1y",
+			want:  ">=2020-03-31",
+		},
+		{
+			name:    "garbage",
+			input:   "next tuesday",
+			wantErr: `invalid date "next tuesday"; examples: "2021-03-01", "2021-03-01..2021-03-31", ">=2021-03-01", ">2w", "<=3mo"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDateRangeQualifier(tt.input, now)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_subtractMonths_dayClamping(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   time.Time
+		months int
+		want   time.Time
+	}{
+		{
+			name:   "march 31 minus one month clamps to february 28 in a non-leap year",
+			from:   time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+			months: 1,
+			want:   time.Date(2021, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "march 31 minus one month clamps to february 29 in a leap year",
+			from:   time.Date(2020, time.March, 31, 0, 0, 0, 0, time.UTC),
+			months: 1,
+			want:   time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "january 31 minus one month rolls back into the previous year",
+			from:   time.Date(2021, time.January, 31, 0, 0, 0, 0, time.UTC),
+			months: 1,
+			want:   time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "may 31 minus two months clamps to march 31, which needs no clamping",
+			from:   time.Date(2021, time.May, 31, 0, 0, 0, 0, time.UTC),
+			months: 2,
+			want:   time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(subtractMonths(tt.from, tt.months)))
+		})
+	}
+}
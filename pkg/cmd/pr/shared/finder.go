@@ -40,9 +40,10 @@ type finder struct {
 	branchConfig func(string) git.BranchConfig
 	progress     progressIndicator
 
-	repo       ghrepo.Interface
-	prNumber   int
-	branchName string
+	// repo caches the base repo across repeated Find calls on the same finder, since
+	// callers that resolve several selectors in a loop (e.g. `pr close a b c`) share one
+	// finder instance for the whole batch.
+	repo ghrepo.Interface
 }
 
 func NewFinder(factory *cmdutil.Factory) PRFinder {
@@ -88,8 +89,9 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 		return nil, nil, errors.New("Find error: no fields specified")
 	}
 
-	if repo, prNumber, err := f.parseURL(opts.Selector); err == nil {
-		f.prNumber = prNumber
+	var prNumber int
+	if repo, parsedNumber, err := f.parseURL(opts.Selector); err == nil {
+		prNumber = parsedNumber
 		f.repo = repo
 	}
 
@@ -101,19 +103,20 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 		f.repo = repo
 	}
 
+	var branchName string
 	if opts.Selector == "" {
-		if branch, prNumber, err := f.parseCurrentBranch(); err != nil {
+		if branch, parsedNumber, err := f.parseCurrentBranch(); err != nil {
 			return nil, nil, err
-		} else if prNumber > 0 {
-			f.prNumber = prNumber
+		} else if parsedNumber > 0 {
+			prNumber = parsedNumber
 		} else {
-			f.branchName = branch
+			branchName = branch
 		}
-	} else if f.prNumber == 0 {
-		if prNumber, err := strconv.Atoi(strings.TrimPrefix(opts.Selector, "#")); err == nil {
-			f.prNumber = prNumber
+	} else if prNumber == 0 {
+		if parsedNumber, err := strconv.Atoi(strings.TrimPrefix(opts.Selector, "#")); err == nil {
+			prNumber = parsedNumber
 		} else {
-			f.branchName = opts.Selector
+			branchName = opts.Selector
 		}
 	}
 
@@ -133,14 +136,14 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 	fields.Add("id") // for additional preload queries below
 
 	var pr *api.PullRequest
-	if f.prNumber > 0 {
+	if prNumber > 0 {
 		if numberFieldOnly {
 			// avoid hitting the API if we already have all the information
-			return &api.PullRequest{Number: f.prNumber}, f.repo, nil
+			return &api.PullRequest{Number: prNumber}, f.repo, nil
 		}
-		pr, err = findByNumber(httpClient, f.repo, f.prNumber, fields.ToSlice())
+		pr, err = findByNumber(httpClient, f.repo, prNumber, fields.ToSlice())
 	} else {
-		pr, err = findForBranch(httpClient, f.repo, opts.BaseBranch, f.branchName, opts.States, fields.ToSlice())
+		pr, err = findForBranch(httpClient, f.repo, opts.BaseBranch, branchName, opts.States, fields.ToSlice())
 	}
 	if err != nil {
 		return pr, f.repo, err
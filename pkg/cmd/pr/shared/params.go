@@ -149,13 +149,18 @@ func AddMetadataToIssueParams(client *api.Client, baseRepo ghrepo.Interface, par
 type FilterOptions struct {
 	Entity     string
 	State      string
-	Assignee   string
+	Assignee   []string
 	Labels     []string
 	Author     string
 	BaseBranch string
 	Mention    string
 	Milestone  string
 	Search     string
+	Sort       string
+	Order      string
+	Owner      string
+	Created    string
+	Updated    string
 
 	Fields []string
 }
@@ -167,7 +172,7 @@ func (opts *FilterOptions) IsDefault() bool {
 	if len(opts.Labels) > 0 {
 		return false
 	}
-	if opts.Assignee != "" {
+	if len(opts.Assignee) > 0 {
 		return false
 	}
 	if opts.Author != "" {
@@ -185,6 +190,12 @@ func (opts *FilterOptions) IsDefault() bool {
 	if opts.Search != "" {
 		return false
 	}
+	if opts.Created != "" {
+		return false
+	}
+	if opts.Updated != "" {
+		return false
+	}
 	return true
 }
 
@@ -210,6 +221,10 @@ func SearchQueryBuild(options FilterOptions) string {
 		q.SetType(githubsearch.PullRequest)
 	}
 
+	if options.Owner != "" {
+		q.InOrganization(options.Owner)
+	}
+
 	switch options.State {
 	case "open":
 		q.SetState(githubsearch.Open)
@@ -219,8 +234,8 @@ func SearchQueryBuild(options FilterOptions) string {
 		q.SetState(githubsearch.Merged)
 	}
 
-	if options.Assignee != "" {
-		q.AssignedTo(options.Assignee)
+	for _, assignee := range options.Assignee {
+		q.AssignedTo(assignee)
 	}
 	for _, label := range options.Labels {
 		q.AddLabel(label)
@@ -237,6 +252,19 @@ func SearchQueryBuild(options FilterOptions) string {
 	if options.Milestone != "" {
 		q.InMilestone(options.Milestone)
 	}
+	if options.Created != "" {
+		q.CreatedRange(options.Created)
+	}
+	if options.Updated != "" {
+		q.UpdatedRange(options.Updated)
+	}
+	if options.Sort != "" {
+		direction := githubsearch.Desc
+		if options.Order == "asc" {
+			direction = githubsearch.Asc
+		}
+		q.SortBy(githubsearch.SortField(options.Sort), direction)
+	}
 	if options.Search != "" {
 		q.AddQuery(options.Search)
 	}
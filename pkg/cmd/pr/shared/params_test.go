@@ -41,7 +41,7 @@ func Test_listURLWithQuery(t *testing.T) {
 				options: FilterOptions{
 					Entity:     "issue",
 					State:      "open",
-					Assignee:   "bo",
+					Assignee:   []string{"bo"},
 					Author:     "ka",
 					BaseBranch: "trunk",
 					Mention:    "nu",
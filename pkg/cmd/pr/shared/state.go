@@ -33,6 +33,11 @@ type IssueMetadataState struct {
 	MetadataResult *api.RepoMetadataResult
 
 	dirty bool // whether user i/o has modified this
+
+	// fetchedMetadata tracks which api.RepoMetadataInput categories have
+	// already been fetched into MetadataResult, so that re-entering the
+	// metadata menu doesn't refetch them.
+	fetchedMetadata api.RepoMetadataInput
 }
 
 func (tb *IssueMetadataState) MarkDirty() {
@@ -151,11 +151,52 @@ type MetadataFetcher struct {
 }
 
 func (mf *MetadataFetcher) RepoMetadataFetch(input api.RepoMetadataInput) (*api.RepoMetadataResult, error) {
-	mf.IO.StartProgressIndicator()
-	metadataResult, err := api.RepoMetadata(mf.APIClient, mf.Repo, input)
-	mf.IO.StopProgressIndicator()
-	mf.State.MetadataResult = metadataResult
-	return metadataResult, err
+	// Re-entering the metadata menu shouldn't refetch categories that were already
+	// fetched for this command invocation.
+	remaining := subtractMetadataInput(input, mf.State.fetchedMetadata)
+	if remaining != (api.RepoMetadataInput{}) {
+		mf.IO.StartProgressIndicator()
+		fetched, err := api.RepoMetadata(mf.APIClient, mf.Repo, remaining)
+		mf.IO.StopProgressIndicator()
+		if err != nil {
+			return nil, err
+		}
+		if mf.State.MetadataResult == nil {
+			mf.State.MetadataResult = fetched
+		} else {
+			mf.State.MetadataResult.Merge(fetched)
+		}
+		mf.State.fetchedMetadata = orMetadataInput(mf.State.fetchedMetadata, remaining)
+
+		for _, category := range fetched.Truncated {
+			fmt.Fprintf(mf.IO.ErrOut, "warning: more than %d %s found, showing only the first %d\n", api.RepoMetadataListLimit, category, api.RepoMetadataListLimit)
+		}
+	}
+
+	return mf.State.MetadataResult, nil
+}
+
+// subtractMetadataInput returns the categories requested by input that aren't
+// already covered by fetched, so that RepoMetadataFetch doesn't refetch
+// categories it already has.
+func subtractMetadataInput(input, fetched api.RepoMetadataInput) api.RepoMetadataInput {
+	return api.RepoMetadataInput{
+		Assignees:  input.Assignees && !fetched.Assignees,
+		Reviewers:  input.Reviewers && !fetched.Reviewers,
+		Labels:     input.Labels && !fetched.Labels,
+		Projects:   input.Projects && !fetched.Projects,
+		Milestones: input.Milestones && !fetched.Milestones,
+	}
+}
+
+func orMetadataInput(a, b api.RepoMetadataInput) api.RepoMetadataInput {
+	return api.RepoMetadataInput{
+		Assignees:  a.Assignees || b.Assignees,
+		Reviewers:  a.Reviewers || b.Reviewers,
+		Labels:     a.Labels || b.Labels,
+		Projects:   a.Projects || b.Projects,
+		Milestones: a.Milestones || b.Milestones,
+	}
 }
 
 type RepoMetadataFetcher interface {
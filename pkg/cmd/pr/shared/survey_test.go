@@ -1,10 +1,13 @@
 package shared
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
 	"github.com/cli/cli/pkg/iostreams"
 	"github.com/cli/cli/pkg/prompt"
 	"github.com/stretchr/testify/assert"
@@ -142,3 +145,99 @@ func TestMetadataSurvey_keepExisting(t *testing.T) {
 	assert.Equal(t, []string{"good first issue"}, state.Labels)
 	assert.Equal(t, []string{"The road to 1.0"}, state.Projects)
 }
+
+func TestMetadataFetcher_RepoMetadataFetch_cachesCategories(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [ { "name": "bug", "id": "BUGID" } ],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryAssignableUsers\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "assignableUsers": {
+			"nodes": [ { "login": "hubot", "id": "HUBOTID" } ],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	io, _, _, _ := iostreams.Test()
+	fetcher := &MetadataFetcher{
+		IO:        io,
+		APIClient: api.NewClient(api.ReplaceTripper(reg)),
+		Repo:      ghrepo.New("OWNER", "REPO"),
+		State:     &IssueMetadataState{},
+	}
+
+	result, err := fetcher.RepoMetadataFetch(api.RepoMetadataInput{Labels: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []api.RepoLabel{{Name: "bug", ID: "BUGID"}}, result.Labels)
+
+	// fetching labels again should be served from the cache, without hitting
+	// a registered stub for labels a second time
+	result, err = fetcher.RepoMetadataFetch(api.RepoMetadataInput{Labels: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []api.RepoLabel{{Name: "bug", ID: "BUGID"}}, result.Labels)
+
+	// a newly requested category is fetched and merged with what's cached
+	result, err = fetcher.RepoMetadataFetch(api.RepoMetadataInput{Labels: true, Assignees: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []api.RepoLabel{{Name: "bug", ID: "BUGID"}}, result.Labels)
+	assert.Equal(t, []api.RepoAssignee{{Login: "hubot", ID: "HUBOTID"}}, result.AssignableUsers)
+}
+
+func TestMetadataFetcher_RepoMetadataFetch_truncationWarningOnce(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	nodes := make([]string, api.RepoMetadataListLimit)
+	for i := range nodes {
+		nodes[i] = fmt.Sprintf(`{ "name": "label%d", "id": "LABELID%d" }`, i, i)
+	}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(fmt.Sprintf(`
+		{ "data": { "repository": { "labels": {
+			"nodes": [%s],
+			"pageInfo": { "hasNextPage": true }
+		} } } }
+		`, strings.Join(nodes, ","))))
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryAssignableUsers\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "assignableUsers": {
+			"nodes": [ { "login": "hubot", "id": "HUBOTID" } ],
+			"pageInfo": { "hasNextPage": false }
+		} } } }
+		`))
+
+	io, _, _, stderr := iostreams.Test()
+	fetcher := &MetadataFetcher{
+		IO:        io,
+		APIClient: api.NewClient(api.ReplaceTripper(reg)),
+		Repo:      ghrepo.New("OWNER", "REPO"),
+		State:     &IssueMetadataState{},
+	}
+
+	_, err := fetcher.RepoMetadataFetch(api.RepoMetadataInput{Labels: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "warning: more than 1000 labels found, showing only the first 1000\n", stderr.String())
+
+	// re-entering the metadata menu without requesting new categories shouldn't
+	// print the truncation warning again
+	_, err = fetcher.RepoMetadataFetch(api.RepoMetadataInput{Labels: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "warning: more than 1000 labels found, showing only the first 1000\n", stderr.String())
+
+	// fetching a newly requested category doesn't reprint the warning for the
+	// already-cached, already-warned-about category
+	_, err = fetcher.RepoMetadataFetch(api.RepoMetadataInput{Labels: true, Assignees: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "warning: more than 1000 labels found, showing only the first 1000\n", stderr.String())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -185,6 +186,26 @@ func (m *templateManager) Choose() (Template, error) {
 	return m.templates[selectedOption], nil
 }
 
+// Select returns the template whose name matches the given name, ignoring case. It returns an
+// error listing the available template names if no template matches.
+func (m *templateManager) Select(name string) (Template, error) {
+	if err := m.memoizedFetch(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range m.templates {
+		if strings.EqualFold(t.Name(), name) {
+			return t, nil
+		}
+	}
+
+	names := make([]string, len(m.templates))
+	for i, t := range m.templates {
+		names[i] = t.Name()
+	}
+	return nil, fmt.Errorf("no template named %q; available templates: %s", name, strings.Join(names, ", "))
+}
+
 func (m *templateManager) memoizedFetch() error {
 	if m.didFetch {
 		return m.fetchError
@@ -0,0 +1,61 @@
+package squash
+
+import (
+	"errors"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/pkg/cmd/pr/merge"
+	"github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// NewCmdSquash is a shorthand for "gh pr merge --squash --delete-branch" that reuses the merge
+// command's API logic.
+func NewCmdSquash(f *cmdutil.Factory, runF func(*merge.MergeOptions) error) *cobra.Command {
+	opts := &merge.MergeOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Branch:     f.Branch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "squash [<number> | <url> | <branch>]",
+		Short: "Squash and merge a pull request, then delete its branch",
+		Long: heredoc.Doc(`
+			Squash and merge a pull request on GitHub, then delete its branch.
+
+			This is a shorthand for "gh pr merge --squash --delete-branch".
+
+			Without an argument, the pull request that belongs to the current branch
+			is selected.
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+				return &cmdutil.FlagError{Err: errors.New("argument required when using the --repo flag")}
+			}
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			opts.MergeMethod = merge.PullRequestMergeMethodSquash
+			opts.DeleteBranch = true
+			opts.IsDeleteBranchIndicated = true
+			opts.CanDeleteLocalBranch = !cmd.Flags().Changed("repo")
+			opts.SubjectSet = cmd.Flags().Changed("subject")
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return merge.Run(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Subject, "subject", "", "Commit subject for the squash commit")
+
+	return cmd
+}
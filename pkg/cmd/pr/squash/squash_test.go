@@ -0,0 +1,153 @@
+package squash
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/internal/run"
+	"github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/test"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func baseRepo(owner, repo, branch string) ghrepo.Interface {
+	return api.InitRepoHostname(&api.Repository{
+		Name:             repo,
+		Owner:            api.RepositoryOwner{Login: owner},
+		DefaultBranchRef: api.BranchRef{Name: branch},
+	}, "github.com")
+}
+
+func runCommand(rt http.RoundTripper, branch string, isTTY bool, cli string) (*test.CmdOut, error) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(isTTY)
+	io.SetStdinTTY(isTTY)
+	io.SetStderrTTY(isTTY)
+
+	factory := &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: rt}, nil
+		},
+		Branch: func() (string, error) {
+			return branch, nil
+		},
+	}
+
+	cmd := NewCmdSquash(factory, nil)
+	cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+	cli = strings.TrimPrefix(cli, "pr squash")
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestPrSquash(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"",
+		&api.PullRequest{
+			ID:          "THE-ID",
+			Number:      3,
+			Title:       "The title of the PR",
+			State:       "OPEN",
+			HeadRefName: "feature",
+		},
+		baseRepo("OWNER", "REPO", "master"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			assert.NotContains(t, input, "commitHeadline")
+		}))
+	http.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/feature"),
+		httpmock.StringResponse(`{}`))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git checkout master`, 0, "")
+	cs.Register(`git rev-parse --verify refs/heads/feature`, 0, "")
+	cs.Register(`git branch -D feature`, 0, "")
+
+	output, err := runCommand(http, "feature", true, "")
+	if err != nil {
+		t.Fatalf("error running command `pr squash`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Squashed and merged pull request #3 (The title of the PR)
+		✓ Deleted branch feature and switched to branch master
+	`), output.Stderr())
+}
+
+func TestPrSquash_withSubject(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	shared.RunCommandFinder(
+		"3",
+		&api.PullRequest{
+			ID:          "THE-ID",
+			Number:      3,
+			Title:       "The title of the PR",
+			State:       "OPEN",
+			HeadRefName: "feature",
+		},
+		baseRepo("OWNER", "REPO", "master"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			assert.Equal(t, "a squashed subject", input["commitHeadline"].(string))
+		}))
+	http.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/feature"),
+		httpmock.StringResponse(`{}`))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git rev-parse --verify refs/heads/feature`, 1, "")
+
+	output, err := runCommand(http, "master", true, `3 --subject "a squashed subject"`)
+	if err != nil {
+		t.Fatalf("error running command `pr squash`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Squashed and merged pull request #3 (The title of the PR)
+		✓ Deleted branch feature
+	`), output.Stderr())
+}
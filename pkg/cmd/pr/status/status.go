@@ -113,8 +113,9 @@ func statusRun(opts *StatusOptions) error {
 	if opts.Exporter != nil {
 		data := map[string]interface{}{
 			"currentBranch": nil,
-			"createdBy":     prPayload.ViewerCreated.PullRequests,
+			"createdByMe":   prPayload.ViewerCreated.PullRequests,
 			"needsReview":   prPayload.ReviewRequested.PullRequests,
+			"mentioning":    prPayload.Mentioning.PullRequests,
 		}
 		if prPayload.CurrentPR != nil {
 			data["currentBranch"] = prPayload.CurrentPR
@@ -308,3 +308,20 @@ Requesting a code review from you
 		t.Errorf("expected %q, got %q", expected, output.String())
 	}
 }
+
+func TestPRStatus_json(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+	http.Register(httpmock.GraphQL(`query PullRequestStatus\b`), httpmock.FileResponse("./fixtures/prStatus.json"))
+
+	output, err := runCommand(http, "blueberries", true, "--json number,title,url")
+	if err != nil {
+		t.Errorf("error running command `pr status`: %v", err)
+	}
+
+	for _, field := range []string{`"createdByMe"`, `"needsReview"`, `"mentioning"`, `"currentBranch"`} {
+		if !strings.Contains(output.String(), field) {
+			t.Errorf("expected output to contain %s, got %q", field, output.String())
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmd/project/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org   string
+	User  string
+	Title string
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new project",
+		Example: heredoc.Doc(`
+			$ gh project create --title "Roadmap"
+			$ gh project create --title "Roadmap" --org my-org
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Title == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--title` is required")}
+			}
+			if err := cmdutil.MutuallyExclusive("specify only one of `--org` or `--user`", opts.Org != "", opts.User != ""); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the project")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Create the project under organization")
+	cmd.Flags().StringVar(&opts.User, "user", "", "Create the project under user")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	project, err := shared.CreateProjectV2(httpClient, host, opts.Org, opts.User, opts.Title)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created project #%d %s\n", cs.SuccessIcon(), project.Number, project.Title)
+	}
+
+	fmt.Fprintln(opts.IO.Out, project.URL)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package create
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wants   CreateOptions
+		wantErr string
+	}{
+		{
+			name:  "title",
+			cli:   `--title Roadmap`,
+			wants: CreateOptions{Title: "Roadmap"},
+		},
+		{
+			name:    "no title",
+			cli:     "",
+			wantErr: "`--title` is required",
+		},
+		{
+			name:    "org and user",
+			cli:     `--title Roadmap --org cli --user octocat`,
+			wantErr: "specify only one of `--org` or `--user`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *CreateOptions
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Title, gotOpts.Title)
+		})
+	}
+}
+
+func Test_createRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ProjectV2Owner\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "id": "USR_1" } } }`))
+	reg.Register(
+		httpmock.GraphQL(`mutation ProjectV2Create\b`),
+		httpmock.StringResponse(`{ "data": { "createProjectV2": { "projectV2": {
+			"id": "PVT_1",
+			"number": 1,
+			"title": "Roadmap",
+			"url": "https://github.com/users/octocat/projects/1"
+		} } } }`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &CreateOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Title: "Roadmap",
+	}
+
+	err := createRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.com/users/octocat/projects/1\n", stdout.String())
+}
@@ -0,0 +1,91 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmd/project/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org   string
+	User  string
+	Limit int
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List projects for a user or organization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid limit: %v", opts.Limit)}
+			}
+			if err := cmdutil.MutuallyExclusive("specify only one of `--org` or `--user`", opts.Org != "", opts.User != ""); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "List projects owned by organization")
+	cmd.Flags().StringVar(&opts.User, "user", "", "List projects owned by user")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of projects to fetch")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	projects, err := shared.ListProjectsV2(httpClient, host, opts.Org, opts.User, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+
+	for _, p := range projects {
+		tp.AddField(fmt.Sprintf("%d", p.Number), nil, cs.Bold)
+		tp.AddField(p.Title, nil, nil)
+		tp.AddField(utils.Pluralize(p.ItemsCount, "item"), nil, cs.Gray)
+		tp.AddField(p.URL, nil, cs.Gray)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
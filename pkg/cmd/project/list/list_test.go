@@ -0,0 +1,116 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wants   ListOptions
+		wantErr string
+	}{
+		{
+			name:  "no arguments",
+			wants: ListOptions{Limit: 30},
+		},
+		{
+			name:  "org",
+			cli:   "--org cli",
+			wants: ListOptions{Limit: 30, Org: "cli"},
+		},
+		{
+			name:  "user",
+			cli:   "--user octocat",
+			wants: ListOptions{Limit: 30, User: "octocat"},
+		},
+		{
+			name:    "org and user",
+			cli:     "--org cli --user octocat",
+			wantErr: "specify only one of `--org` or `--user`",
+		},
+		{
+			name:    "invalid limit",
+			cli:     "--limit 0",
+			wantErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+			assert.Equal(t, tt.wants.User, gotOpts.User)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func Test_listRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ProjectV2List\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "projectsV2": { "nodes": [
+			{
+				"id": "PVT_1",
+				"number": 1,
+				"title": "Roadmap",
+				"url": "https://github.com/users/octocat/projects/1",
+				"items": { "totalCount": 3 }
+			}
+		] } } } }`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &ListOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Limit: 30,
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		1	Roadmap	3 items	https://github.com/users/octocat/projects/1
+	`), stdout.String())
+}
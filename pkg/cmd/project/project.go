@@ -0,0 +1,28 @@
+package project
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdCreate "github.com/cli/cli/pkg/cmd/project/create"
+	cmdList "github.com/cli/cli/pkg/cmd/project/list"
+	cmdView "github.com/cli/cli/pkg/cmd/project/view"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project <command>",
+		Short: "Manage GitHub Projects (Projects beta)",
+		Long: heredoc.Doc(`
+			Work with the new, board-style GitHub Projects. Use --org or --user on
+			any subcommand to operate on a project owned by an organization or
+			user; without either flag, the authenticated user is assumed.
+		`),
+	}
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdView.NewCmdView(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,298 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+)
+
+// ProjectV2 is a GitHub Projects (new, board-style) project.
+type ProjectV2 struct {
+	ID               string
+	Number           int
+	Title            string
+	ShortDescription string
+	URL              string
+	Closed           bool
+	ItemsCount       int
+	Fields           []string
+}
+
+// projectV2Node mirrors the shape of a ProjectV2 GraphQL object; it exists
+// separately from ProjectV2 so the flattening of Items/Fields into plain
+// values stays out of the public struct.
+type projectV2Node struct {
+	ID               string
+	Number           int
+	Title            string
+	ShortDescription string
+	URL              string
+	Closed           bool
+	Items            struct {
+		TotalCount int
+	}
+	Fields struct {
+		Nodes []struct {
+			Name string
+		}
+	}
+}
+
+func (n projectV2Node) export() ProjectV2 {
+	fields := make([]string, 0, len(n.Fields.Nodes))
+	for _, f := range n.Fields.Nodes {
+		if f.Name != "" {
+			fields = append(fields, f.Name)
+		}
+	}
+	return ProjectV2{
+		ID:               n.ID,
+		Number:           n.Number,
+		Title:            n.Title,
+		ShortDescription: n.ShortDescription,
+		URL:              n.URL,
+		Closed:           n.Closed,
+		ItemsCount:       n.Items.TotalCount,
+		Fields:           fields,
+	}
+}
+
+// projectV2Fragment is shared by every query below; ProjectV2FieldCommon is the
+// interface implemented by all of a project's field configuration types
+// (text, single select, iteration, ...), so this only ever asks for their name.
+const projectV2Fragment = `
+fragment projectV2Fields on ProjectV2 {
+	id
+	number
+	title
+	shortDescription
+	url
+	closed
+	items {
+		totalCount
+	}
+	fields(first: 20) {
+		nodes {
+			... on ProjectV2FieldCommon {
+				name
+			}
+		}
+	}
+}`
+
+// ListProjectsV2 fetches the projects owned by an organization, a user, or (when
+// neither is given) the authenticated viewer.
+func ListProjectsV2(httpClient *http.Client, hostname, org, user string, limit int) ([]ProjectV2, error) {
+	if limit > 100 {
+		limit = 100
+	}
+
+	type response struct {
+		Organization struct {
+			ProjectsV2 struct {
+				Nodes []projectV2Node
+			}
+		}
+		User struct {
+			ProjectsV2 struct {
+				Nodes []projectV2Node
+			}
+		}
+		Viewer struct {
+			ProjectsV2 struct {
+				Nodes []projectV2Node
+			}
+		}
+	}
+
+	variables := map[string]interface{}{"first": limit}
+
+	var query, ownerField string
+	switch {
+	case org != "":
+		ownerField, query = "organization", "organization(login: $login)"
+		variables["login"] = org
+	case user != "":
+		ownerField, query = "user", "user(login: $login)"
+		variables["login"] = user
+	default:
+		ownerField, query = "viewer", "viewer"
+	}
+
+	queryArgs := "$first: Int!"
+	if _, ok := variables["login"]; ok {
+		queryArgs = "$login: String!, " + queryArgs
+	}
+
+	gqlQuery := projectV2Fragment + fmt.Sprintf(`
+	query ProjectV2List(%s) {
+		%s {
+			projectsV2(first: $first, orderBy: {field: TITLE, direction: ASC}) {
+				nodes {
+					...projectV2Fields
+				}
+			}
+		}
+	}`, queryArgs, query)
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var resp response
+	if err := apiClient.GraphQL(hostname, gqlQuery, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	var nodes []projectV2Node
+	switch ownerField {
+	case "organization":
+		nodes = resp.Organization.ProjectsV2.Nodes
+	case "user":
+		nodes = resp.User.ProjectsV2.Nodes
+	default:
+		nodes = resp.Viewer.ProjectsV2.Nodes
+	}
+
+	projects := make([]ProjectV2, len(nodes))
+	for i, n := range nodes {
+		projects[i] = n.export()
+	}
+	return projects, nil
+}
+
+// ProjectV2ByNumber fetches a single project owned by an organization, a user, or
+// (when neither is given) the authenticated viewer.
+func ProjectV2ByNumber(httpClient *http.Client, hostname, org, user string, number int) (*ProjectV2, error) {
+	type response struct {
+		Organization struct {
+			ProjectV2 *projectV2Node
+		}
+		User struct {
+			ProjectV2 *projectV2Node
+		}
+		Viewer struct {
+			ProjectV2 *projectV2Node
+		}
+	}
+
+	variables := map[string]interface{}{"number": number}
+
+	var query, ownerField string
+	switch {
+	case org != "":
+		ownerField, query = "organization", "organization(login: $login)"
+		variables["login"] = org
+	case user != "":
+		ownerField, query = "user", "user(login: $login)"
+		variables["login"] = user
+	default:
+		ownerField, query = "viewer", "viewer"
+	}
+
+	queryArgs := "$number: Int!"
+	if _, ok := variables["login"]; ok {
+		queryArgs = "$login: String!, " + queryArgs
+	}
+
+	gqlQuery := projectV2Fragment + fmt.Sprintf(`
+	query ProjectV2ByNumber(%s) {
+		%s {
+			projectV2(number: $number) {
+				...projectV2Fields
+			}
+		}
+	}`, queryArgs, query)
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var resp response
+	if err := apiClient.GraphQL(hostname, gqlQuery, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	var node *projectV2Node
+	switch ownerField {
+	case "organization":
+		node = resp.Organization.ProjectV2
+	case "user":
+		node = resp.User.ProjectV2
+	default:
+		node = resp.Viewer.ProjectV2
+	}
+
+	if node == nil {
+		return nil, fmt.Errorf("no project found for number %d", number)
+	}
+
+	project := node.export()
+	return &project, nil
+}
+
+// CreateProjectV2 creates a new project owned by an organization, a user, or
+// (when neither is given) the authenticated viewer.
+func CreateProjectV2(httpClient *http.Client, hostname, org, user, title string) (*ProjectV2, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	ownerID, err := ownerID(apiClient, hostname, org, user)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve owner: %w", err)
+	}
+
+	gqlQuery := projectV2Fragment + `
+	mutation ProjectV2Create($input: CreateProjectV2Input!) {
+		createProjectV2(input: $input) {
+			projectV2 {
+				...projectV2Fields
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"ownerId": ownerID,
+			"title":   title,
+		},
+	}
+
+	var resp struct {
+		CreateProjectV2 struct {
+			ProjectV2 projectV2Node
+		}
+	}
+	if err := apiClient.GraphQL(hostname, gqlQuery, variables, &resp); err != nil {
+		return nil, err
+	}
+
+	project := resp.CreateProjectV2.ProjectV2.export()
+	return &project, nil
+}
+
+func ownerID(apiClient *api.Client, hostname, org, user string) (string, error) {
+	switch {
+	case org != "":
+		var resp struct {
+			Organization struct{ ID string }
+		}
+		query := `query ProjectV2Owner($login: String!) { organization(login: $login) { id } }`
+		if err := apiClient.GraphQL(hostname, query, map[string]interface{}{"login": org}, &resp); err != nil {
+			return "", err
+		}
+		return resp.Organization.ID, nil
+	case user != "":
+		var resp struct {
+			User struct{ ID string }
+		}
+		query := `query ProjectV2Owner($login: String!) { user(login: $login) { id } }`
+		if err := apiClient.GraphQL(hostname, query, map[string]interface{}{"login": user}, &resp); err != nil {
+			return "", err
+		}
+		return resp.User.ID, nil
+	default:
+		var resp struct {
+			Viewer struct{ ID string }
+		}
+		query := `query ProjectV2Owner { viewer { id } }`
+		if err := apiClient.GraphQL(hostname, query, nil, &resp); err != nil {
+			return "", err
+		}
+		return resp.Viewer.ID, nil
+	}
+}
@@ -0,0 +1,99 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmd/project/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*http.Client, error)
+
+	Org    string
+	User   string
+	Number int
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view <number>",
+		Short: "View a project",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			number, err := strconv.Atoi(args[0])
+			if err != nil {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid project number: %q", args[0])}
+			}
+			opts.Number = number
+
+			if err := cmdutil.MutuallyExclusive("specify only one of `--org` or `--user`", opts.Org != "", opts.User != ""); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Project owned by organization")
+	cmd.Flags().StringVar(&opts.User, "user", "", "Project owned by user")
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	project, err := shared.ProjectV2ByNumber(httpClient, host, opts.Org, opts.User, opts.Number)
+	if err != nil {
+		return err
+	}
+
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintln(out, cs.Bold(project.Title))
+	if project.ShortDescription != "" {
+		fmt.Fprintln(out, project.ShortDescription)
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, utils.Pluralize(project.ItemsCount, "item"))
+	if len(project.Fields) > 0 {
+		fmt.Fprintf(out, "Fields: %s\n", strings.Join(project.Fields, ", "))
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, project.URL)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package view
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdView(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wants   ViewOptions
+		wantErr string
+	}{
+		{
+			name:  "number",
+			cli:   "1",
+			wants: ViewOptions{Number: 1},
+		},
+		{
+			name:  "with org",
+			cli:   "1 --org cli",
+			wants: ViewOptions{Number: 1, Org: "cli"},
+		},
+		{
+			name:    "invalid number",
+			cli:     "not-a-number",
+			wantErr: `invalid project number: "not-a-number"`,
+		},
+		{
+			name:    "org and user",
+			cli:     "1 --org cli --user octocat",
+			wantErr: "specify only one of `--org` or `--user`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ViewOptions
+			cmd := NewCmdView(f, func(opts *ViewOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Number, gotOpts.Number)
+			assert.Equal(t, tt.wants.Org, gotOpts.Org)
+		})
+	}
+}
+
+func Test_viewRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query ProjectV2ByNumber\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "projectV2": {
+			"id": "PVT_1",
+			"number": 1,
+			"title": "Roadmap",
+			"shortDescription": "Our quarterly plan",
+			"url": "https://github.com/users/octocat/projects/1",
+			"items": { "totalCount": 2 },
+			"fields": { "nodes": [ { "name": "Status" }, { "name": "Title" } ] }
+		} } } }`))
+
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &ViewOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Number: 1,
+	}
+
+	err := viewRun(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "Roadmap\nOur quarterly plan\n\n2 items\nFields: Status, Title\n\nhttps://github.com/users/octocat/projects/1\n", stdout.String())
+}
@@ -20,7 +20,12 @@ type Release struct {
 	PublishedAt  time.Time
 }
 
-func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int) ([]Release, error) {
+type filterOptions struct {
+	excludeDrafts      bool
+	excludePreReleases bool
+}
+
+func fetchReleases(httpClient *http.Client, repo ghrepo.Interface, limit int, filters filterOptions) ([]Release, error) {
 	type responseData struct {
 		Repository struct {
 			Releases struct {
@@ -57,6 +62,12 @@ loop:
 		}
 
 		for _, r := range query.Repository.Releases.Nodes {
+			if filters.excludeDrafts && r.IsDraft {
+				continue
+			}
+			if filters.excludePreReleases && r.IsPrerelease {
+				continue
+			}
 			releases = append(releases, r)
 			if len(releases) == limit {
 				break loop
@@ -18,7 +18,9 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 
-	LimitResults int
+	LimitResults       int
+	ExcludeDrafts      bool
+	ExcludePreReleases bool
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
@@ -43,6 +45,8 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().IntVarP(&opts.LimitResults, "limit", "L", 30, "Maximum number of items to fetch")
+	cmd.Flags().BoolVar(&opts.ExcludeDrafts, "exclude-drafts", false, "Exclude draft releases")
+	cmd.Flags().BoolVar(&opts.ExcludePreReleases, "exclude-pre-releases", false, "Exclude pre-releases")
 
 	return cmd
 }
@@ -58,7 +62,10 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	releases, err := fetchReleases(httpClient, baseRepo, opts.LimitResults)
+	releases, err := fetchReleases(httpClient, baseRepo, opts.LimitResults, filterOptions{
+		excludeDrafts:      opts.ExcludeDrafts,
+		excludePreReleases: opts.ExcludePreReleases,
+	})
 	if err != nil {
 		return err
 	}
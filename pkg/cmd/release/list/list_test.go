@@ -100,6 +100,20 @@ func Test_listRun(t *testing.T) {
 			`),
 			wantStderr: ``,
 		},
+		{
+			name:  "exclude drafts and pre-releases",
+			isTTY: true,
+			opts: ListOptions{
+				LimitResults:       30,
+				ExcludeDrafts:      true,
+				ExcludePreReleases: true,
+			},
+			wantStdout: heredoc.Doc(`
+				The big 1.0   Latest  (v1.0.0)  about 1 day ago
+				New features          (v0.9.2)  about 1 day ago
+			`),
+			wantStderr: ``,
+		},
 		{
 			name:  "machine-readable",
 			isTTY: false,
@@ -51,6 +51,7 @@ type Release struct {
 	TarballURL string `json:"tarball_url"`
 	ZipballURL string `json:"zipball_url"`
 	URL        string `json:"html_url"`
+	AssetsURL  string `json:"assets_url"`
 	Assets     []ReleaseAsset
 
 	Author struct {
@@ -151,6 +152,10 @@ func FetchRelease(httpClient *http.Client, baseRepo ghrepo.Interface, tagName st
 		return nil, err
 	}
 
+	if err := fillReleaseAssets(httpClient, &release); err != nil {
+		return nil, err
+	}
+
 	return &release, nil
 }
 
@@ -184,9 +189,61 @@ func FetchLatestRelease(httpClient *http.Client, baseRepo ghrepo.Interface) (*Re
 		return nil, err
 	}
 
+	if err := fillReleaseAssets(httpClient, &release); err != nil {
+		return nil, err
+	}
+
 	return &release, nil
 }
 
+// fillReleaseAssets fully paginates the release's assets via its dedicated
+// assets endpoint, so that releases with more assets than fit on a single
+// page don't end up with a truncated Assets list.
+func fillReleaseAssets(httpClient *http.Client, release *Release) error {
+	if release.AssetsURL == "" {
+		return nil
+	}
+
+	var assets []ReleaseAsset
+	perPage := 100
+	page := 1
+	for {
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s?per_page=%d&page=%d", release.AssetsURL, perPage, page), nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode > 299 {
+			return api.HandleHTTPError(resp)
+		}
+
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		var assetsPage []ReleaseAsset
+		if err := json.Unmarshal(b, &assetsPage); err != nil {
+			return err
+		}
+		assets = append(assets, assetsPage...)
+
+		if len(assetsPage) < perPage {
+			break
+		}
+		page++
+	}
+
+	release.Assets = assets
+	return nil
+}
+
 // FindDraftRelease returns the latest draft release that matches tagName.
 func FindDraftRelease(httpClient *http.Client, baseRepo ghrepo.Interface, tagName string) (*Release, error) {
 	path := fmt.Sprintf("repos/%s/%s/releases", baseRepo.RepoOwner(), baseRepo.RepoName())
@@ -223,6 +280,9 @@ func FindDraftRelease(httpClient *http.Client, baseRepo ghrepo.Interface, tagNam
 
 		for _, r := range releases {
 			if r.IsDraft && r.TagName == tagName {
+				if err := fillReleaseAssets(httpClient, &r); err != nil {
+					return nil, err
+				}
 				return &r, nil
 			}
 		}
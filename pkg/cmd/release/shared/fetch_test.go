@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchRelease_paginatesAssets(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/releases/tags/v1.2.3"),
+		httpmock.JSONResponse(map[string]interface{}{
+			"tag_name":   "v1.2.3",
+			"draft":      false,
+			"assets_url": "https://api.github.com/repos/OWNER/REPO/releases/1/assets",
+		}))
+
+	firstPage := make([]map[string]interface{}, 100)
+	for i := range firstPage {
+		firstPage[i] = map[string]interface{}{"name": "asset", "download_count": i}
+	}
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/releases/1/assets"),
+		httpmock.JSONResponse(firstPage))
+
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/releases/1/assets"),
+		httpmock.JSONResponse([]map[string]interface{}{
+			{"name": "last-asset", "download_count": 42},
+		}))
+
+	httpClient := &http.Client{Transport: reg}
+
+	release, err := FetchRelease(httpClient, ghrepo.New("OWNER", "REPO"), "v1.2.3")
+	require.NoError(t, err)
+
+	assert.Equal(t, 101, len(release.Assets))
+	assert.Equal(t, "last-asset", release.Assets[100].Name)
+	assert.Equal(t, 42, release.Assets[100].DownloadCount)
+}
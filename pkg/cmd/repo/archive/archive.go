@@ -0,0 +1,99 @@
+package archive
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/repo/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type ArchiveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg   string
+	Confirmed bool
+}
+
+func NewCmdArchive(f *cmdutil.Factory, runF func(*ArchiveOptions) error) *cobra.Command {
+	opts := &ArchiveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "archive [<repository>]",
+		Short: "Archive a repository",
+		Long: `Archive a GitHub repository.
+
+With no argument, the repository for the current directory is archived.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return archiveRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func archiveRun(opts *ArchiveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	toArchive, err := shared.RepoArg(httpClient, opts.BaseRepo, opts.RepoArg)
+	if err != nil {
+		return err
+	}
+
+	fullName := ghrepo.FullName(toArchive)
+
+	if !opts.Confirmed && opts.IO.CanPrompt() {
+		var answer string
+		err = prompt.SurveyAskOne(
+			&survey.Input{
+				Message: fmt.Sprintf("You're going to archive %s. This action can be reversed with `gh repo unarchive`. To confirm, type the repository name:", fullName),
+			},
+			&answer,
+		)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(answer, fullName) {
+			fmt.Fprintf(opts.IO.Out, "Repository %s was not archived.\n", fullName)
+			return nil
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	if err := api.EditRepoArchival(apiClient, toArchive, true); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Archived %s\n", cs.SuccessIcon(), fullName)
+	}
+
+	return nil
+}
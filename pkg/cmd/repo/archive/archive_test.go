@@ -0,0 +1,171 @@
+package archive
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdArchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    ArchiveOptions
+		wantErr string
+	}{
+		{
+			name: "no argument",
+			args: "",
+			want: ArchiveOptions{
+				RepoArg:   "",
+				Confirmed: false,
+			},
+		},
+		{
+			name: "repo argument",
+			args: "OWNER/REPO",
+			want: ArchiveOptions{
+				RepoArg:   "OWNER/REPO",
+				Confirmed: false,
+			},
+		},
+		{
+			name: "skip confirm",
+			args: "OWNER/REPO -y",
+			want: ArchiveOptions{
+				RepoArg:   "OWNER/REPO",
+				Confirmed: true,
+			},
+		},
+		{
+			name:    "too many arguments",
+			args:    "OWNER/REPO extra",
+			wantErr: "accepts at most 1 arg(s), received 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *ArchiveOptions
+			cmd := NewCmdArchive(f, func(o *ArchiveOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.RepoArg, opts.RepoArg)
+			assert.Equal(t, tt.want.Confirmed, opts.Confirmed)
+		})
+	}
+}
+
+func Test_archiveRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		isTTY      bool
+		opts       ArchiveOptions
+		askStubs   func(*prompt.AskStubber)
+		wantStdout string
+	}{
+		{
+			name:  "skip confirmation",
+			isTTY: true,
+			opts: ArchiveOptions{
+				RepoArg:   "OWNER/REPO",
+				Confirmed: true,
+			},
+			wantStdout: "✓ Archived OWNER/REPO\n",
+		},
+		{
+			name:  "confirmed interactively",
+			isTTY: true,
+			opts: ArchiveOptions{
+				RepoArg: "OWNER/REPO",
+			},
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubOne("OWNER/REPO")
+			},
+			wantStdout: "✓ Archived OWNER/REPO\n",
+		},
+		{
+			name:  "declined interactively",
+			isTTY: true,
+			opts: ArchiveOptions{
+				RepoArg: "OWNER/REPO",
+			},
+			askStubs: func(as *prompt.AskStubber) {
+				as.StubOne("nope")
+			},
+			wantStdout: "Repository OWNER/REPO was not archived.\n",
+		},
+		{
+			name:  "non-interactive",
+			isTTY: false,
+			opts: ArchiveOptions{
+				RepoArg: "OWNER/REPO",
+			},
+			wantStdout: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			fakeHTTP := &httpmock.Registry{}
+			if tt.wantStdout != "Repository OWNER/REPO was not archived.\n" {
+				fakeHTTP.Register(httpmock.REST("PATCH", "repos/OWNER/REPO"), httpmock.StatusStringResponse(200, "{}"))
+			}
+
+			as, teardown := prompt.InitAskStubber()
+			defer teardown()
+			if tt.askStubs != nil {
+				tt.askStubs(as)
+			}
+
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: fakeHTTP}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := archiveRun(&tt.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
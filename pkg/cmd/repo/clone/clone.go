@@ -3,6 +3,7 @@ package clone
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -21,15 +22,19 @@ type CloneOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	GitArgs    []string
-	Repository string
+	GitArgs            []string
+	Repository         string
+	Depth              int
+	Branch             string
+	UpstreamRemoteName string
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
 	opts := &CloneOptions{
-		IO:         f.IOStreams,
-		HttpClient: f.HttpClient,
-		Config:     f.Config,
+		IO:                 f.IOStreams,
+		HttpClient:         f.HttpClient,
+		Config:             f.Config,
+		UpstreamRemoteName: "upstream",
 	}
 
 	cmd := &cobra.Command{
@@ -46,6 +51,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 
 			Pass additional 'git clone' flags by listing them after '--'.
 		`),
+		Example: heredoc.Doc(`
+			$ gh repo clone cli/cli
+			$ gh repo clone cli/cli --depth 1 --branch trunk
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Repository = args[0]
 			opts.GitArgs = args[1:]
@@ -58,6 +67,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().IntVar(&opts.Depth, "depth", 0, "Create a shallow clone with a history truncated to the specified number of commits")
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Clone only the history leading to the tip of `branch`")
+	cmd.Flags().StringVar(&opts.UpstreamRemoteName, "upstream-remote-name", "upstream", "Upstream remote name when cloning a fork; pass an empty string to disable")
+
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -149,20 +162,37 @@ func cloneRun(opts *CloneOptions) error {
 		canonicalCloneURL = strings.TrimSuffix(canonicalCloneURL, ".git") + ".wiki.git"
 	}
 
-	cloneDir, err := git.RunClone(canonicalCloneURL, opts.GitArgs)
+	gitArgs := opts.GitArgs
+	if opts.Depth > 0 {
+		gitArgs = append(gitArgs, "--depth", strconv.Itoa(opts.Depth))
+	}
+	if opts.Branch != "" {
+		gitArgs = append(gitArgs, "--branch", opts.Branch, "--single-branch")
+	}
+
+	cloneDir, err := git.RunClone(canonicalCloneURL, gitArgs)
 	if err != nil {
 		return err
 	}
 
 	// If the repo is a fork, add the parent as an upstream
-	if canonicalRepo.Parent != nil {
+	if canonicalRepo.Parent != nil && opts.UpstreamRemoteName != "" {
 		protocol, err := cfg.Get(canonicalRepo.Parent.RepoHost(), "git_protocol")
 		if err != nil {
 			return err
 		}
 		upstreamURL := ghrepo.FormatRemoteURL(canonicalRepo.Parent, protocol)
 
-		err = git.AddUpstreamRemote(upstreamURL, cloneDir, []string{canonicalRepo.Parent.DefaultBranchRef.Name})
+		err = git.AddNamedRemote(upstreamURL, cloneDir, opts.UpstreamRemoteName, []string{canonicalRepo.Parent.DefaultBranchRef.Name})
+		if err != nil {
+			return err
+		}
+
+		// origin already points at the fork, so mark it as the base repo to
+		// avoid an interactive prompt the next time gh needs to resolve the
+		// base repository for this directory now that upstream is also a
+		// recognizable GitHub remote
+		err = git.SetRemoteResolutionInDir(cloneDir, "origin", "base")
 		if err != nil {
 			return err
 		}
@@ -52,10 +52,28 @@ func TestNewCmdClone(t *testing.T) {
 				GitArgs:    []string{"--depth", "1", "--recurse-submodules"},
 			},
 		},
+		{
+			name: "depth flag",
+			args: "OWNER/REPO --depth 1",
+			wantOpts: CloneOptions{
+				Repository: "OWNER/REPO",
+				GitArgs:    []string{},
+				Depth:      1,
+			},
+		},
+		{
+			name: "branch flag",
+			args: "OWNER/REPO --branch trunk",
+			wantOpts: CloneOptions{
+				Repository: "OWNER/REPO",
+				GitArgs:    []string{},
+				Branch:     "trunk",
+			},
+		},
 		{
 			name:    "unknown argument",
-			args:    "OWNER/REPO --depth 1",
-			wantErr: "unknown flag: --depth\nSeparate git clone flags with '--'.",
+			args:    "OWNER/REPO --depth2 1",
+			wantErr: "unknown flag: --depth2\nSeparate git clone flags with '--'.",
 		},
 	}
 	for _, tt := range testCases {
@@ -90,11 +108,17 @@ func TestNewCmdClone(t *testing.T) {
 
 			assert.Equal(t, tt.wantOpts.Repository, opts.Repository)
 			assert.Equal(t, tt.wantOpts.GitArgs, opts.GitArgs)
+			assert.Equal(t, tt.wantOpts.Depth, opts.Depth)
+			assert.Equal(t, tt.wantOpts.Branch, opts.Branch)
 		})
 	}
 }
 
 func runCloneCommand(httpClient *http.Client, cli string) (*test.CmdOut, error) {
+	return runCloneCommandWithConfig(httpClient, cli, config.NewBlankConfig())
+}
+
+func runCloneCommandWithConfig(httpClient *http.Client, cli string, cfg config.Config) (*test.CmdOut, error) {
 	io, stdin, stdout, stderr := iostreams.Test()
 	fac := &cmdutil.Factory{
 		IOStreams: io,
@@ -102,7 +126,7 @@ func runCloneCommand(httpClient *http.Client, cli string) (*test.CmdOut, error)
 			return httpClient, nil
 		},
 		Config: func() (config.Config, error) {
-			return config.NewBlankConfig(), nil
+			return cfg, nil
 		},
 	}
 
@@ -179,6 +203,21 @@ func Test_RepoClone(t *testing.T) {
 			args: "https://github.com/owner/repo.wiki",
 			want: "git clone https://github.com/OWNER/REPO.wiki.git",
 		},
+		{
+			name: "depth flag",
+			args: "OWNER/REPO --depth 1",
+			want: "git clone --depth 1 https://github.com/OWNER/REPO.git",
+		},
+		{
+			name: "branch flag",
+			args: "OWNER/REPO --branch trunk",
+			want: "git clone --branch trunk --single-branch https://github.com/OWNER/REPO.git",
+		},
+		{
+			name: "depth and branch flags with directory",
+			args: "OWNER/REPO target_directory --depth 1 --branch trunk",
+			want: "git clone --depth 1 --branch trunk --single-branch https://github.com/OWNER/REPO.git target_directory",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -244,6 +283,7 @@ func Test_RepoClone_hasParent(t *testing.T) {
 
 	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
 	cs.Register(`git -C REPO remote add -t trunk -f upstream https://github.com/hubot/ORIG.git`, 0, "")
+	cs.Register(`git -C REPO config --add remote\.origin\.gh-resolved base`, 0, "")
 
 	_, err := runCloneCommand(httpClient, "OWNER/REPO")
 	if err != nil {
@@ -251,6 +291,154 @@ func Test_RepoClone_hasParent(t *testing.T) {
 	}
 }
 
+func Test_RepoClone_hasParent_customUpstreamRemoteName(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					},
+					"parent": {
+						"name": "ORIG",
+						"owner": {
+							"login": "hubot"
+						},
+						"defaultBranchRef": {
+							"name": "trunk"
+						}
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
+	cs.Register(`git -C REPO remote add -t trunk -f parent https://github.com/hubot/ORIG.git`, 0, "")
+	cs.Register(`git -C REPO config --add remote\.origin\.gh-resolved base`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "OWNER/REPO --upstream-remote-name parent")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_hasParent_upstreamRemoteNameDisabled(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					},
+					"parent": {
+						"name": "ORIG",
+						"owner": {
+							"login": "hubot"
+						},
+						"defaultBranchRef": {
+							"name": "trunk"
+						}
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone https://github.com/OWNER/REPO.git`, 0, "")
+
+	_, err := runCloneCommand(httpClient, `OWNER/REPO --upstream-remote-name ""`)
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_hasParent_sshProtocol(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					},
+					"parent": {
+						"name": "ORIG",
+						"owner": {
+							"login": "hubot"
+						},
+						"defaultBranchRef": {
+							"name": "trunk"
+						}
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone git@github\.com:OWNER/REPO\.git`, 0, "")
+	cs.Register(`git -C REPO remote add -t trunk -f upstream git@github\.com:hubot/ORIG\.git`, 0, "")
+	cs.Register(`git -C REPO config --add remote\.origin\.gh-resolved base`, 0, "")
+
+	cfg := config.NewFromString("---\ngit_protocol: ssh\n")
+
+	_, err := runCloneCommandWithConfig(httpClient, "OWNER/REPO", cfg)
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
+func Test_RepoClone_hasParent_GHESHost(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryInfo\b`),
+		httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					},
+					"parent": {
+						"name": "ORIG",
+						"owner": {
+							"login": "hubot"
+						},
+						"defaultBranchRef": {
+							"name": "trunk"
+						}
+					}
+				} } }
+				`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git clone https://ghes\.io/OWNER/REPO\.git`, 0, "")
+	cs.Register(`git -C REPO remote add -t trunk -f upstream https://ghes\.io/hubot/ORIG\.git`, 0, "")
+	cs.Register(`git -C REPO config --add remote\.origin\.gh-resolved base`, 0, "")
+
+	_, err := runCloneCommand(httpClient, "https://ghes.io/OWNER/REPO")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+}
+
 func Test_RepoClone_withoutUsername(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
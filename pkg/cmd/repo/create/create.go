@@ -26,17 +26,18 @@ type CreateOptions struct {
 	Config     func() (config.Config, error)
 	IO         *iostreams.IOStreams
 
-	Name          string
-	Description   string
-	Homepage      string
-	Team          string
-	Template      string
-	EnableIssues  bool
-	EnableWiki    bool
-	Public        bool
-	Private       bool
-	Internal      bool
-	ConfirmSubmit bool
+	Name               string
+	Description        string
+	Homepage           string
+	Team               string
+	Template           string
+	IncludeAllBranches bool
+	EnableIssues       bool
+	EnableWiki         bool
+	Public             bool
+	Private            bool
+	Internal           bool
+	ConfirmSubmit      bool
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -106,6 +107,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return &cmdutil.FlagError{Err: errors.New("The `--template` option is not supported with `--homepage`, `--team`, `--enable-issues`, or `--enable-wiki`")}
 			}
 
+			if opts.IncludeAllBranches && opts.Template == "" {
+				return &cmdutil.FlagError{Err: errors.New("The `--include-all-branches` option is only supported when using `--template`")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -117,6 +122,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Homepage, "homepage", "h", "", "Repository home page `URL`")
 	cmd.Flags().StringVarP(&opts.Team, "team", "t", "", "The `name` of the organization team to be granted access")
 	cmd.Flags().StringVarP(&opts.Template, "template", "p", "", "Make the new repository based on a template `repository`")
+	cmd.Flags().BoolVar(&opts.IncludeAllBranches, "include-all-branches", false, "Include all branches from template repository")
 	cmd.Flags().BoolVar(&opts.EnableIssues, "enable-issues", true, "Enable issues in the new repository")
 	cmd.Flags().BoolVar(&opts.EnableWiki, "enable-wiki", true, "Enable wiki in the new repository")
 	cmd.Flags().BoolVar(&opts.Public, "public", false, "Make the new repository public")
@@ -166,7 +172,7 @@ func createRun(opts *CreateOptions) error {
 
 	// Trigger interactive prompt if name is not passed
 	if !isNameAnArg {
-		newName, newDesc, newVisibility, err := interactiveRepoCreate(isDescEmpty, isVisibilityPassed, opts.Name)
+		newName, newDesc, newVisibility, newTemplate, err := interactiveRepoCreate(isDescEmpty, isVisibilityPassed, opts.Name, opts.Template != "")
 		if err != nil {
 			return err
 		}
@@ -179,6 +185,9 @@ func createRun(opts *CreateOptions) error {
 		if newVisibility != "" {
 			visibility = newVisibility
 		}
+		if newTemplate != "" {
+			opts.Template = newTemplate
+		}
 	} else {
 		// Go for a prompt only if visibility isn't passed
 		if !isVisibilityPassed {
@@ -269,7 +278,7 @@ func createRun(opts *CreateOptions) error {
 	}
 
 	if opts.ConfirmSubmit {
-		repo, err := repoCreate(httpClient, repoToCreate.RepoHost(), input, opts.Template)
+		repo, err := repoCreate(httpClient, repoToCreate.RepoHost(), input, opts.Template, opts.IncludeAllBranches)
 		if err != nil {
 			return err
 		}
@@ -382,7 +391,7 @@ func localInit(io *iostreams.IOStreams, remoteURL, path, checkoutBranch string)
 	return run.PrepareCmd(gitCheckout).Run()
 }
 
-func interactiveRepoCreate(isDescEmpty bool, isVisibilityPassed bool, repoName string) (string, string, string, error) {
+func interactiveRepoCreate(isDescEmpty bool, isVisibilityPassed bool, repoName string, hasTemplate bool) (string, string, string, string, error) {
 	qs := []*survey.Question{}
 
 	repoNameQuestion := &survey.Question{
@@ -425,10 +434,44 @@ func interactiveRepoCreate(isDescEmpty bool, isVisibilityPassed bool, repoName s
 	err := prompt.SurveyAsk(qs, &answers)
 
 	if err != nil {
-		return "", "", "", err
+		return "", "", "", "", err
 	}
 
-	return answers.RepoName, answers.RepoDescription, strings.ToUpper(answers.RepoVisibility), nil
+	template := ""
+	if !hasTemplate {
+		template, err = askForTemplate()
+		if err != nil {
+			return "", "", "", "", err
+		}
+	}
+
+	return answers.RepoName, answers.RepoDescription, strings.ToUpper(answers.RepoVisibility), template, nil
+}
+
+// askForTemplate asks the user whether this repository should be created from a
+// template repository, and if so, which one. The question is skipped entirely
+// unless the user opts in, since most repositories aren't created from a template.
+func askForTemplate() (string, error) {
+	wantsTemplate := false
+	err := prompt.SurveyAskOne(&survey.Confirm{
+		Message: "Create from a template repository?",
+		Default: false,
+	}, &wantsTemplate)
+	if err != nil {
+		return "", err
+	}
+	if !wantsTemplate {
+		return "", nil
+	}
+
+	template := ""
+	err = prompt.SurveyAskOne(&survey.Input{
+		Message: "Template repository",
+	}, &template, survey.WithValidator(survey.Required))
+	if err != nil {
+		return "", err
+	}
+	return template, nil
 }
 
 func confirmSubmission(repoName string, repoOwner string, inLocalRepo bool) (bool, error) {
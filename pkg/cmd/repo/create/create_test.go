@@ -413,6 +413,72 @@ func TestRepoCreate_template(t *testing.T) {
 	}
 }
 
+func TestRepoCreate_templateIncludeAllBranches(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`mutation CloneTemplateRepository\b`),
+		httpmock.StringResponse(`
+		{ "data": { "cloneTemplateRepository": {
+			"repository": {
+				"id": "REPOID",
+				"name": "REPO",
+				"owner": {
+					"login": "OWNER"
+				},
+				"url": "https://github.com/OWNER/REPO"
+			}
+		} } }`))
+
+	reg.StubRepoInfoResponse("OWNER", "REPO", "main")
+
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"ID":"OWNERID"}}}`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --show-toplevel`, 1, "")
+	cs.Register(`git init REPO`, 0, "")
+	cs.Register(`git -C REPO remote add`, 0, "")
+	cs.Register(`git -C REPO fetch origin \+refs/heads/main:refs/remotes/origin/main`, 0, "")
+	cs.Register(`git -C REPO checkout main`, 0, "")
+
+	_, surveyTearDown := prompt.InitAskStubber()
+	defer surveyTearDown()
+
+	_, err := runCommand(httpClient, "REPO -y --private --template='OWNER/REPO' --include-all-branches", true)
+	if err != nil {
+		t.Errorf("error running command `repo create`: %v", err)
+		return
+	}
+
+	var reqBody struct {
+		Query     string
+		Variables struct {
+			Input map[string]interface{}
+		}
+	}
+
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[2].Body)
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	if includeAllBranches := reqBody.Variables.Input["includeAllBranches"].(bool); !includeAllBranches {
+		t.Errorf("expected includeAllBranches to be true")
+	}
+}
+
+func TestRepoCreate_includeAllBranchesWithoutTemplate(t *testing.T) {
+	httpClient := &http.Client{Transport: &httpmock.Registry{}}
+
+	_, err := runCommand(httpClient, "REPO -y --private --include-all-branches", true)
+	if err == nil || err.Error() != "The `--include-all-branches` option is only supported when using `--template`" {
+		t.Fatalf("got error: %v", err)
+	}
+}
+
 func TestRepoCreate_withoutNameArg(t *testing.T) {
 	reg := &httpmock.Registry{}
 	reg.Register(
@@ -456,6 +522,7 @@ func TestRepoCreate_withoutNameArg(t *testing.T) {
 			Value: "PRIVATE",
 		},
 	})
+	as.StubOne(false)
 	as.Stub([]*prompt.QuestionStub{
 		{
 			Name:  "confirmSubmit",
@@ -494,3 +561,104 @@ func TestRepoCreate_withoutNameArg(t *testing.T) {
 		t.Errorf("expected %q, got %q", "OWNERID", ownerId)
 	}
 }
+
+func TestRepoCreate_withoutNameArg_templateOptIn(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.StubRepoInfoResponse("TEMPLATEOWNER", "TEMPLATEREPO", "main")
+	reg.Register(
+		httpmock.REST("GET", "users/OWNER"),
+		httpmock.StringResponse(`{ "node_id": "OWNERID" }`))
+	reg.Register(
+		httpmock.GraphQL(`mutation CloneTemplateRepository\b`),
+		httpmock.StringResponse(`
+		{ "data": { "cloneTemplateRepository": {
+			"repository": {
+				"id": "REPOID",
+				"name": "REPO",
+				"owner": {
+					"login": "OWNER"
+				},
+				"url": "https://github.com/OWNER/REPO"
+			}
+		} } }`))
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git remote add -f origin https://github\.com/OWNER/REPO\.git`, 0, "")
+	cs.Register(`git rev-parse --show-toplevel`, 0, "")
+
+	as, surveyTearDown := prompt.InitAskStubber()
+	defer surveyTearDown()
+
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "repoName",
+			Value: "OWNER/REPO",
+		},
+		{
+			Name:  "repoDescription",
+			Value: "DESCRIPTION",
+		},
+		{
+			Name:  "repoVisibility",
+			Value: "PRIVATE",
+		},
+	})
+	as.StubOne(true)
+	as.StubOne("TEMPLATEOWNER/TEMPLATEREPO")
+	as.Stub([]*prompt.QuestionStub{
+		{
+			Name:  "confirmSubmit",
+			Value: true,
+		},
+	})
+
+	output, err := runCommand(httpClient, "", true)
+	if err != nil {
+		t.Errorf("error running command `repo create`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Created repository OWNER/REPO on GitHub\n✓ Added remote https://github.com/OWNER/REPO.git\n", output.Stderr())
+
+	var reqBody struct {
+		Query     string
+		Variables struct {
+			Input map[string]interface{}
+		}
+	}
+	bodyBytes, _ := ioutil.ReadAll(reg.Requests[2].Body)
+	_ = json.Unmarshal(bodyBytes, &reqBody)
+	if repoID := reqBody.Variables.Input["repositoryId"].(string); repoID != "REPOID" {
+		t.Errorf("expected %q, got %q", "REPOID", repoID)
+	}
+}
+
+func TestRepoCreate_template_forbidden(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.StubRepoInfoResponse("OWNER", "REPO", "main")
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{"data":{"viewer":{"ID":"OWNERID"}}}`))
+	reg.Register(
+		httpmock.GraphQL(`mutation CloneTemplateRepository\b`),
+		httpmock.StringResponse(`
+		{ "data": null, "errors": [
+			{ "type": "FORBIDDEN", "message": "You don't have permission to create repositories under this owner" }
+		] }`))
+	httpClient := &http.Client{Transport: reg}
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git rev-parse --show-toplevel`, 1, "")
+
+	_, surveyTearDown := prompt.InitAskStubber()
+	defer surveyTearDown()
+
+	_, err := runCommand(httpClient, "REPO -y --private --template='OWNER/REPO'", true)
+	assert.EqualError(t, err, "you do not have permission to create repositories from a template in this owner")
+}
@@ -1,6 +1,7 @@
 package create
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -26,12 +27,13 @@ type repoTemplateInput struct {
 	Visibility string `json:"visibility"`
 	OwnerID    string `json:"ownerId,omitempty"`
 
-	RepositoryID string `json:"repositoryId,omitempty"`
-	Description  string `json:"description,omitempty"`
+	RepositoryID       string `json:"repositoryId,omitempty"`
+	Description        string `json:"description,omitempty"`
+	IncludeAllBranches bool   `json:"includeAllBranches,omitempty"`
 }
 
 // repoCreate creates a new GitHub repository
-func repoCreate(client *http.Client, hostname string, input repoCreateInput, templateRepositoryID string) (*api.Repository, error) {
+func repoCreate(client *http.Client, hostname string, input repoCreateInput, templateRepositoryID string, includeAllBranches bool) (*api.Repository, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
 	if input.TeamID != "" {
@@ -65,10 +67,11 @@ func repoCreate(client *http.Client, hostname string, input repoCreateInput, tem
 		}
 
 		templateInput := repoTemplateInput{
-			Name:         input.Name,
-			Visibility:   input.Visibility,
-			OwnerID:      input.OwnerID,
-			RepositoryID: templateRepositoryID,
+			Name:               input.Name,
+			Visibility:         input.Visibility,
+			OwnerID:            input.OwnerID,
+			RepositoryID:       templateRepositoryID,
+			IncludeAllBranches: includeAllBranches,
 		}
 
 		variables := map[string]interface{}{
@@ -88,6 +91,14 @@ func repoCreate(client *http.Client, hostname string, input repoCreateInput, tem
 		}
 		`, variables, &response)
 		if err != nil {
+			var gqlErr *api.GraphQLErrorResponse
+			if errors.As(err, &gqlErr) {
+				for _, e := range gqlErr.Errors {
+					if e.Type == "FORBIDDEN" {
+						return nil, fmt.Errorf("you do not have permission to create repositories from a template in this owner")
+					}
+				}
+			}
 			return nil, err
 		}
 
@@ -26,7 +26,7 @@ func Test_RepoCreate(t *testing.T) {
 		HomepageURL: "http://example.com",
 	}
 
-	_, err := repoCreate(httpClient, "github.com", input, "")
+	_, err := repoCreate(httpClient, "github.com", input, "", false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -35,3 +35,34 @@ func Test_RepoCreate(t *testing.T) {
 		t.Fatalf("expected 1 HTTP request, seen %d", len(reg.Requests))
 	}
 }
+
+func Test_RepoCreate_TemplateIncludeAllBranches(t *testing.T) {
+	reg := &httpmock.Registry{}
+	httpClient := api.NewHTTPClient(api.ReplaceTripper(reg))
+
+	reg.Register(
+		httpmock.GraphQL(`query UserCurrent\b`),
+		httpmock.StringResponse(`{ "data": { "viewer": { "id": "OWNERID" } } }`),
+	)
+	reg.Register(
+		httpmock.GraphQL(`mutation CloneTemplateRepository\b`),
+		httpmock.GraphQLMutation(`{}`,
+			func(inputs map[string]interface{}) {
+				assert.Equal(t, inputs["repositoryId"], "REPOID")
+				assert.Equal(t, inputs["includeAllBranches"], true)
+			}),
+	)
+
+	input := repoCreateInput{
+		Name: "my-repo",
+	}
+
+	_, err := repoCreate(httpClient, "github.com", input, "REPOID", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(reg.Requests) != 2 {
+		t.Fatalf("expected 2 HTTP requests, seen %d", len(reg.Requests))
+	}
+}
@@ -0,0 +1,109 @@
+package add
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/repo/deploykey/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AddOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	KeyFile  string
+	Key      string
+	Title    string
+	ReadOnly bool
+}
+
+func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
+	opts := &AddOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a deploy key to a repository",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.KeyFile == "" && opts.Key == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--key-file` or `--key` required")}
+			}
+			if opts.KeyFile != "" && opts.Key != "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("specify only one of `--key-file` or `--key`")}
+			}
+			if opts.Title == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--title` required")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return addRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.KeyFile, "key-file", "", "Path to the public key file")
+	cmd.Flags().StringVar(&opts.Key, "key", "", "The public key contents")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the new key")
+	cmd.Flags().BoolVar(&opts.ReadOnly, "read-only", false, "Restrict the key to read-only access")
+
+	return cmd
+}
+
+func addRun(opts *AddOptions) error {
+	var keyText string
+	if opts.KeyFile != "" {
+		b, err := ioutil.ReadFile(opts.KeyFile)
+		if err != nil {
+			return err
+		}
+		keyText = string(b)
+	} else {
+		keyText = opts.Key
+	}
+	keyText = strings.TrimSpace(keyText)
+
+	if _, err := shared.Fingerprint(keyText); err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	err = deployKeyAdd(apiClient, repo, keyText, opts.Title, opts.ReadOnly)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Deploy key added to %s\n", cs.SuccessIcon(), ghrepo.FullName(repo))
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package add
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPubKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIF38rcz8ooTfsPv1v5K53nhzNsoRgZKuBRkcZZaRBUyt test"
+
+func Test_NewCmdAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no key",
+			cli:     "--title mykey",
+			wantErr: "`--key-file` or `--key` required",
+		},
+		{
+			name:    "no title",
+			cli:     `--key "` + testPubKey + `"`,
+			wantErr: "`--title` required",
+		},
+		{
+			name:    "both key flags",
+			cli:     `--key "` + testPubKey + `" --key-file key.pub --title mykey`,
+			wantErr: "specify only one of `--key-file` or `--key`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			cmd := NewCmdAdd(f, func(opts *AddOptions) error { return nil })
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_addRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/keys"),
+		httpmock.StringResponse(`{}`))
+
+	err := addRun(&AddOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		Key:      testPubKey,
+		Title:    "deploy key",
+		ReadOnly: true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Deploy key added to OWNER/REPO\n", stderr.String())
+}
+
+func Test_addRun_invalidKey(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	err := addRun(&AddOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Key:   "not a key",
+		Title: "deploy key",
+	})
+	assert.Error(t, err)
+}
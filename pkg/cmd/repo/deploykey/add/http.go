@@ -0,0 +1,26 @@
+package add
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func deployKeyAdd(client *api.Client, repo ghrepo.Interface, keyText, title string, readOnly bool) error {
+	payload := map[string]interface{}{
+		"key":       keyText,
+		"title":     title,
+		"read_only": readOnly,
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/keys", ghrepo.FullName(repo))
+	return client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payloadBytes), nil)
+}
@@ -0,0 +1,13 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func deployKeyDelete(client *api.Client, repo ghrepo.Interface, keyID string) error {
+	path := fmt.Sprintf("repos/%s/keys/%s", ghrepo.FullName(repo), keyID)
+	return client.REST(repo.RepoHost(), "DELETE", path, nil, nil)
+}
@@ -0,0 +1,51 @@
+package list
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// DeployKeyFields lists the fields of deployKey that are available to the --json flag.
+var DeployKeyFields = []string{
+	"id",
+	"key",
+	"title",
+	"createdAt",
+	"readOnly",
+}
+
+type deployKey struct {
+	ID        int
+	Key       string
+	Title     string
+	CreatedAt time.Time `json:"created_at"`
+	ReadOnly  bool      `json:"read_only"`
+}
+
+func (dk *deployKey) ExportData(fields []string) *map[string]interface{} {
+	v := reflect.ValueOf(dk).Elem()
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		sf := v.FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(f, name)
+		})
+		data[f] = sf.Interface()
+	}
+
+	return &data
+}
+
+func deployKeyList(client *api.Client, repo ghrepo.Interface) ([]deployKey, error) {
+	var keys []deployKey
+	err := client.REST(repo.RepoHost(), "GET", fmt.Sprintf("repos/%s/keys", ghrepo.FullName(repo)), nil, &keys)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
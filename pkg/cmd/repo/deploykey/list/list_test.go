@@ -0,0 +1,129 @@
+package list
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+type testExporter struct {
+	fields []string
+}
+
+func (e *testExporter) Fields() []string {
+	return e.fields
+}
+
+func (e *testExporter) Write(w io.Writer, data interface{}, colorize bool) error {
+	keys := data.([]deployKey)
+	exported := make([]*map[string]interface{}, len(keys))
+	for i, k := range keys {
+		exported[i] = k.ExportData(e.fields)
+	}
+	return json.NewEncoder(w).Encode(exported)
+}
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		httpStubs  func(*httpmock.Registry)
+		isTTY      bool
+		exporter   cmdutil.Exporter
+		wantStdout string
+		wantStderr string
+		wantErr    bool
+	}{
+		{
+			name: "list tty",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/keys"),
+					httpmock.StringResponse(`[
+						{
+							"id": 1234,
+							"key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIF38rcz8ooTfsPv1v5K53nhzNsoRgZKuBRkcZZaRBUyt test",
+							"title": "deploy key",
+							"read_only": true
+						}
+					]`),
+				)
+			},
+			isTTY:      true,
+			wantStdout: "1234  SHA256:LHIVTvHDMHlkvrf/FzeiQWSfsiS/Mr7H1vnTG80/KuI  deploy key  read-only\n",
+		},
+		{
+			name: "no keys",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/keys"),
+					httpmock.StringResponse(`[]`),
+				)
+			},
+			wantStderr: "No deploy keys present in the repository.\n",
+			wantErr:    true,
+		},
+		{
+			name: "json",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/keys"),
+					httpmock.StringResponse(`[
+						{
+							"id": 1234,
+							"key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIF38rcz8ooTfsPv1v5K53nhzNsoRgZKuBRkcZZaRBUyt test",
+							"title": "deploy key",
+							"read_only": true
+						}
+					]`),
+				)
+			},
+			exporter:   &testExporter{fields: []string{"id", "title", "readOnly"}},
+			wantStdout: "[{\"id\":1234,\"readOnly\":true,\"title\":\"deploy key\"}]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+
+			opts := &ListOptions{
+				IO: io,
+				Config: func() (config.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+				Exporter: tt.exporter,
+			}
+
+			err := listRun(opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
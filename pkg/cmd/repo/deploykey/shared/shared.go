@@ -0,0 +1,18 @@
+package shared
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Fingerprint parses keyText as an SSH public key and returns its SHA256
+// fingerprint, e.g. "SHA256:abcdef...". It returns an error if keyText is
+// not a valid public key in OpenSSH authorized_keys format.
+func Fingerprint(keyText string) (string, error) {
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyText))
+	if err != nil {
+		return "", fmt.Errorf("could not parse public key: %w", err)
+	}
+	return ssh.FingerprintSHA256(pubKey), nil
+}
@@ -0,0 +1,108 @@
+package dispatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DispatchOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	EventType     string
+	ClientPayload string
+	PayloadFile   string
+}
+
+func NewCmdDispatch(f *cmdutil.Factory, runF func(*DispatchOptions) error) *cobra.Command {
+	opts := &DispatchOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	var payloadFile string
+
+	cmd := &cobra.Command{
+		Use:   "dispatch",
+		Short: "Trigger a repository_dispatch event",
+		Long: heredoc.Doc(`
+			Trigger a repository_dispatch webhook event, which can be used to kick off
+			a workflow that listens for the "repository_dispatch" event type.
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.EventType == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--event-type` required")}
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--client-payload` or `--payload-file`",
+				opts.ClientPayload != "",
+				payloadFile != "",
+			); err != nil {
+				return err
+			}
+
+			if payloadFile != "" {
+				b, err := cmdutil.ReadFile(payloadFile, opts.IO.In)
+				if err != nil {
+					return err
+				}
+				opts.ClientPayload = string(b)
+			}
+
+			if opts.ClientPayload != "" && !json.Valid([]byte(opts.ClientPayload)) {
+				return &cmdutil.FlagError{Err: fmt.Errorf("client payload is not valid JSON")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return dispatchRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.EventType, "event-type", "", "The `type` of event to trigger")
+	cmd.Flags().StringVar(&opts.ClientPayload, "client-payload", "", "JSON `payload` to pass with the event")
+	cmd.Flags().StringVarP(&payloadFile, "payload-file", "F", "", "Read the client payload from `file` (use \"-\" to read from standard input)")
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
+
+func dispatchRun(opts *DispatchOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	err = dispatchEvent(apiClient, repo, opts.EventType, opts.ClientPayload)
+	if err != nil {
+		return fmt.Errorf("failed to trigger repository dispatch event: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Triggered %s event for %s\n", cs.SuccessIcon(), cs.Bold(opts.EventType), ghrepo.FullName(repo))
+	}
+
+	return nil
+}
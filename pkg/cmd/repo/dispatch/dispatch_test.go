@@ -0,0 +1,81 @@
+package dispatch
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdDispatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no event-type",
+			cli:     `--client-payload '{}'`,
+			wantErr: "`--event-type` required",
+		},
+		{
+			name:    "client-payload and payload-file",
+			cli:     `--event-type deploy --client-payload '{}' --payload-file payload.json`,
+			wantErr: "specify only one of `--client-payload` or `--payload-file`",
+		},
+		{
+			name:    "invalid JSON payload",
+			cli:     `--event-type deploy --client-payload 'not json'`,
+			wantErr: "client payload is not valid JSON",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{
+				IOStreams: iostreams.System(),
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			cmd := NewCmdDispatch(f, func(opts *DispatchOptions) error { return nil })
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_dispatchRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/dispatches"),
+		httpmock.StringResponse(`{}`))
+
+	err := dispatchRun(&DispatchOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		EventType:     "deploy",
+		ClientPayload: `{"env":"production"}`,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Triggered deploy event for OWNER/REPO\n", stderr.String())
+}
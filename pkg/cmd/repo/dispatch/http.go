@@ -0,0 +1,27 @@
+package dispatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+func dispatchEvent(client *api.Client, repo ghrepo.Interface, eventType, clientPayload string) error {
+	payload := map[string]interface{}{
+		"event_type": eventType,
+	}
+	if clientPayload != "" {
+		payload["client_payload"] = json.RawMessage(clientPayload)
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("repos/%s/dispatches", ghrepo.FullName(repo))
+	return client.REST(repo.RepoHost(), "POST", path, bytes.NewReader(payloadBytes), nil)
+}
@@ -174,6 +174,10 @@ func forkRun(opts *ForkOptions) error {
 	forkedRepo, err := api.ForkRepo(apiClient, repoToFork, opts.Organization)
 	opts.IO.StopProgressIndicator()
 	if err != nil {
+		var httpErr api.HTTPError
+		if opts.Organization != "" && errors.As(err, &httpErr) && httpErr.StatusCode == 403 {
+			return fmt.Errorf("you don't have permission to fork into the %s organization", opts.Organization)
+		}
 		return fmt.Errorf("failed to fork: %w", err)
 	}
 
@@ -184,6 +188,9 @@ func forkRun(opts *ForkOptions) error {
 	// we assume the fork already existed and report an error.
 	createdAgo := Since(forkedRepo.CreatedAt)
 	if createdAgo > time.Minute {
+		if opts.Organization != "" {
+			return fmt.Errorf("%s already has a fork of %s at %s", opts.Organization, ghrepo.FullName(repoToFork), ghrepo.GenerateRepoURL(forkedRepo, ""))
+		}
 		if connectedToTerminal {
 			fmt.Fprintf(stderr, "%s %s %s\n",
 				cs.Yellow("!"),
@@ -562,6 +562,31 @@ func TestRepoFork_ForkAlreadyExistsAndCloneNonTty(t *testing.T) {
 	reg.Verify(t)
 }
 
+func TestRepoFork_org_already_forked(t *testing.T) {
+	defer stubSince(2 * time.Minute)()
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/forks"),
+		httpmock.StringResponse(`{"name":"REPO", "owner":{"login":"batmanshome"}, "created_at": "2011-01-26T19:01:12Z"}`))
+	defer reg.Verify(t)
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := runCommand(httpClient, nil, true, "--clone=false OWNER/REPO --org batmanshome")
+	assert.EqualError(t, err, "batmanshome already has a fork of OWNER/REPO at https://github.com/batmanshome/REPO")
+}
+
+func TestRepoFork_org_permission_denied(t *testing.T) {
+	reg := &httpmock.Registry{}
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/forks"),
+		httpmock.StatusStringResponse(403, `{"message": "You don't have permission to do this"}`))
+	defer reg.Verify(t)
+	httpClient := &http.Client{Transport: reg}
+
+	_, err := runCommand(httpClient, nil, true, "--clone=false OWNER/REPO --org batmanshome")
+	assert.EqualError(t, err, "you don't have permission to fork into the batmanshome organization")
+}
+
 func TestRepoFork_outside_survey_yes(t *testing.T) {
 	defer stubSince(2 * time.Second)()
 	reg := &httpmock.Registry{}
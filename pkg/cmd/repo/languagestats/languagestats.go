@@ -0,0 +1,172 @@
+package languagestats
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/repo/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type LanguageStatsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg string
+	Top     int
+	JSON    bool
+}
+
+func NewCmdLanguageStats(f *cmdutil.Factory, runF func(*LanguageStatsOptions) error) *cobra.Command {
+	opts := &LanguageStatsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "language-stats [<repository>]",
+		Short: "Show a breakdown of languages used in a repository",
+		Long: `Show a breakdown of languages used in a repository.
+
+With no argument, the repository for the current directory is used.`,
+		Example: heredoc.Doc(`
+			$ gh repo language-stats
+			$ gh repo language-stats cli/cli
+			$ gh repo language-stats --top 5
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if cmd.Flags().Changed("top") && opts.Top < 1 {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid value for --top: %v", opts.Top)}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return languageStatsRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Top, "top", 0, "Limit the list to the top `N` languages")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the raw byte counts as JSON")
+
+	return cmd
+}
+
+func languageStatsRun(opts *LanguageStatsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := shared.RepoArg(httpClient, opts.BaseRepo, opts.RepoArg)
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	languages := map[string]int{}
+	path := fmt.Sprintf("repos/%s/%s/languages", repo.RepoOwner(), repo.RepoName())
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &languages); err != nil {
+		return err
+	}
+
+	stats := sortLanguages(languages)
+	if opts.Top > 0 && opts.Top < len(stats) {
+		stats = stats[:opts.Top]
+	}
+
+	if opts.JSON {
+		raw := map[string]int{}
+		for _, s := range stats {
+			raw[s.Name] = s.Bytes
+		}
+		enc := json.NewEncoder(opts.IO.Out)
+		return enc.Encode(raw)
+	}
+
+	if len(stats) == 0 {
+		fmt.Fprintln(opts.IO.Out, "no language data available for this repository")
+		return nil
+	}
+
+	var total int
+	for _, s := range stats {
+		total += s.Bytes
+	}
+
+	if !opts.IO.IsStdoutTTY() {
+		for _, s := range stats {
+			fmt.Fprintf(opts.IO.Out, "%s\t%d\n", s.Name, s.Bytes)
+		}
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	const barWidth = 30
+	for _, s := range stats {
+		pct := float64(s.Bytes) / float64(total) * 100
+		bar := renderBar(pct, barWidth)
+		fmt.Fprintf(opts.IO.Out, "%-20s %s %s\n", s.Name, cs.Cyan(bar), fmt.Sprintf("%.1f%%", pct))
+	}
+
+	return nil
+}
+
+type languageStat struct {
+	Name  string
+	Bytes int
+}
+
+func sortLanguages(languages map[string]int) []languageStat {
+	stats := make([]languageStat, 0, len(languages))
+	for name, bytes := range languages {
+		stats = append(stats, languageStat{Name: name, Bytes: bytes})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Bytes != stats[j].Bytes {
+			return stats[i].Bytes > stats[j].Bytes
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	return stats
+}
+
+func renderBar(pct float64, width int) string {
+	blocks := []string{" ", "▏", "▎", "▍", "▌", "▋", "▊", "▉", "█"}
+	filled := pct / 100 * float64(width)
+	full := int(filled)
+	if full > width {
+		full = width
+	}
+	remainder := filled - float64(full)
+
+	bar := ""
+	for i := 0; i < full; i++ {
+		bar += blocks[8]
+	}
+	if full < width {
+		idx := int(remainder * 8)
+		bar += blocks[idx]
+		full++
+	}
+	for i := full; i < width; i++ {
+		bar += blocks[0]
+	}
+
+	return bar
+}
@@ -0,0 +1,147 @@
+package languagestats
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdLanguageStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    LanguageStatsOptions
+		wantErr string
+	}{
+		{
+			name: "no argument",
+			args: "",
+			want: LanguageStatsOptions{
+				RepoArg: "",
+				Top:     0,
+			},
+		},
+		{
+			name: "repo argument",
+			args: "OWNER/REPO",
+			want: LanguageStatsOptions{
+				RepoArg: "OWNER/REPO",
+				Top:     0,
+			},
+		},
+		{
+			name: "top flag",
+			args: "OWNER/REPO --top 3",
+			want: LanguageStatsOptions{
+				RepoArg: "OWNER/REPO",
+				Top:     3,
+			},
+		},
+		{
+			name:    "invalid top",
+			args:    "OWNER/REPO --top 0",
+			wantErr: "invalid value for --top: 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *LanguageStatsOptions
+			cmd := NewCmdLanguageStats(f, func(o *LanguageStatsOptions) error {
+				opts = o
+				return nil
+			})
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.RepoArg, opts.RepoArg)
+			assert.Equal(t, tt.want.Top, opts.Top)
+		})
+	}
+}
+
+func Test_languageStatsRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		isTTY      bool
+		opts       LanguageStatsOptions
+		wantStdout string
+	}{
+		{
+			name:  "non-tty",
+			isTTY: false,
+			opts: LanguageStatsOptions{
+				RepoArg: "OWNER/REPO",
+			},
+			wantStdout: "Go\t300\nRuby\t100\n",
+		},
+		{
+			name:  "json",
+			isTTY: false,
+			opts: LanguageStatsOptions{
+				RepoArg: "OWNER/REPO",
+				JSON:    true,
+			},
+			wantStdout: "{\"Go\":300,\"Ruby\":100}\n",
+		},
+		{
+			name:  "top limits results",
+			isTTY: false,
+			opts: LanguageStatsOptions{
+				RepoArg: "OWNER/REPO",
+				Top:     1,
+			},
+			wantStdout: "Go\t300\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+
+			fakeHTTP := &httpmock.Registry{}
+			fakeHTTP.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/languages"),
+				httpmock.StringResponse(`{"Go": 300, "Ruby": 100}`))
+
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: fakeHTTP}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := languageStatsRun(&tt.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
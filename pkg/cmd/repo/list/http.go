@@ -22,13 +22,15 @@ type FilterOptions struct {
 	Fork        bool
 	Source      bool
 	Language    string
+	Topic       string
 	Archived    bool
 	NonArchived bool
+	Sort        string // pushed, created, name
 	Fields      []string
 }
 
 func listRepos(client *http.Client, hostname string, limit int, owner string, filter FilterOptions) (*RepositoryList, error) {
-	if filter.Language != "" || filter.Archived || filter.NonArchived {
+	if filter.Language != "" || filter.Topic != "" || filter.Archived || filter.NonArchived {
 		return searchRepos(client, hostname, limit, owner, filter)
 	}
 
@@ -75,16 +77,24 @@ func listRepos(client *http.Client, hostname string, limit int, owner string, fi
 		}
 	}
 
+	orderField := "PUSHED_AT"
+	switch filter.Sort {
+	case "created":
+		orderField = "CREATED_AT"
+	case "name":
+		orderField = "NAME"
+	}
+
 	query := fmt.Sprintf(`query RepositoryList(%s) {
 		%s {
 			login
-			repositories(first: $perPage, after: $endCursor, privacy: $privacy, isFork: $fork, ownerAffiliations: OWNER, orderBy: { field: PUSHED_AT, direction: DESC }) {
+			repositories(first: $perPage, after: $endCursor, privacy: $privacy, isFork: $fork, ownerAffiliations: OWNER, orderBy: { field: %s, direction: DESC }) {
 				nodes{%s}
 				totalCount
 				pageInfo{hasNextPage,endCursor}
 			}
 		}
-	}`, strings.Join(inputs, ","), ownerConnection, api.RepositoryGraphQL(filter.Fields))
+	}`, strings.Join(inputs, ","), ownerConnection, orderField, api.RepositoryGraphQL(filter.Fields))
 
 	apiClient := api.NewClientFromHTTP(client)
 	listResult := RepositoryList{}
@@ -197,6 +207,10 @@ func searchQuery(owner string, filter FilterOptions) string {
 		q.SetLanguage(filter.Language)
 	}
 
+	if filter.Topic != "" {
+		q.SetTopic(filter.Topic)
+	}
+
 	switch filter.Visibility {
 	case "public":
 		q.SetVisibility(githubsearch.Public)
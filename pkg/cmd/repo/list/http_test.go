@@ -60,6 +60,48 @@ func Test_listReposWithLanguage(t *testing.T) {
 	assert.Equal(t, `user:@me language:go fork:true sort:updated-desc`, searchData.Variables["query"])
 }
 
+func Test_listReposWithSort(t *testing.T) {
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+
+	var queryData struct {
+		Query     string
+		Variables map[string]interface{}
+	}
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryList\b`),
+		func(req *http.Request) (*http.Response, error) {
+			jsonData, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			err = json.Unmarshal(jsonData, &queryData)
+			if err != nil {
+				return nil, err
+			}
+
+			respBody, err := os.Open("./fixtures/repoList.json")
+			if err != nil {
+				return nil, err
+			}
+
+			return &http.Response{
+				StatusCode: 200,
+				Request:    req,
+				Body:       respBody,
+			}, nil
+		},
+	)
+
+	client := http.Client{Transport: &reg}
+	_, err := listRepos(&client, "github.com", 10, "", FilterOptions{
+		Sort: "created",
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, queryData.Query, "orderBy: { field: CREATED_AT, direction: DESC }")
+}
+
 func Test_searchQuery(t *testing.T) {
 	type args struct {
 		owner  string
@@ -131,6 +173,16 @@ func Test_searchQuery(t *testing.T) {
 			},
 			want: `user:@me language:ruby fork:true sort:updated-desc`,
 		},
+		{
+			name: "with topic",
+			args: args{
+				owner: "",
+				filter: FilterOptions{
+					Topic: "cli",
+				},
+			},
+			want: `user:@me topic:cli fork:true sort:updated-desc`,
+		},
 		{
 			name: "only archived",
 			args: args{
@@ -28,8 +28,10 @@ type ListOptions struct {
 	Fork        bool
 	Source      bool
 	Language    string
+	Topic       string
 	Archived    bool
 	NonArchived bool
+	Sort        string
 
 	Now func() time.Time
 }
@@ -66,6 +68,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: fmt.Errorf("specify only one of `--archived` or `--no-archived`")}
 			}
 
+			if opts.Sort != "" && !isValidSort(opts.Sort) {
+				return &cmdutil.FlagError{Err: fmt.Errorf("invalid sort: %q. Expected one of pushed, created, or name", opts.Sort)}
+			}
+
 			if flagPrivate {
 				opts.Visibility = "private"
 			} else if flagPublic {
@@ -89,8 +95,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().BoolVar(&opts.Source, "source", false, "Show only non-forks")
 	cmd.Flags().BoolVar(&opts.Fork, "fork", false, "Show only forks")
 	cmd.Flags().StringVarP(&opts.Language, "language", "l", "", "Filter by primary coding language")
+	cmd.Flags().StringVar(&opts.Topic, "topic", "", "Filter by topic")
 	cmd.Flags().BoolVar(&opts.Archived, "archived", false, "Show only archived repositories")
 	cmd.Flags().BoolVar(&opts.NonArchived, "no-archived", false, "Omit archived repositories")
+	cmd.Flags().StringVar(&opts.Sort, "sort", "pushed", "Sort fetched repositories: {created|name|pushed}; not applied together with --language, --topic, --archived or --no-archived")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.RepositoryFields)
 
 	return cmd
@@ -109,8 +117,10 @@ func listRun(opts *ListOptions) error {
 		Fork:        opts.Fork,
 		Source:      opts.Source,
 		Language:    opts.Language,
+		Topic:       opts.Topic,
 		Archived:    opts.Archived,
 		NonArchived: opts.NonArchived,
+		Sort:        opts.Sort,
 		Fields:      defaultFields,
 	}
 	if opts.Exporter != nil {
@@ -169,7 +179,7 @@ func listRun(opts *ListOptions) error {
 	}
 
 	if opts.IO.IsStdoutTTY() {
-		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || filter.Language != ""
+		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || filter.Language != "" || filter.Topic != ""
 		title := listHeader(listResult.Owner, len(listResult.Repositories), listResult.TotalCount, hasFilters)
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
@@ -194,6 +204,10 @@ func listHeader(owner string, matchCount, totalMatchCount int, hasFilters bool)
 	return fmt.Sprintf("Showing %d of %d repositories in @%s%s", matchCount, totalMatchCount, owner, matchStr)
 }
 
+func isValidSort(sort string) bool {
+	return sort == "pushed" || sort == "created" || sort == "name"
+}
+
 func repoInfo(r api.Repository) string {
 	var tags []string
 
@@ -37,6 +37,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -51,6 +52,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -65,6 +67,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -79,6 +82,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -93,6 +97,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -107,6 +112,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -121,6 +127,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -135,6 +142,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "go",
 				Archived:    false,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -149,6 +157,7 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    true,
 				NonArchived: false,
+				Sort:        "pushed",
 			},
 		},
 		{
@@ -163,8 +172,45 @@ func TestNewCmdList(t *testing.T) {
 				Language:    "",
 				Archived:    false,
 				NonArchived: true,
+				Sort:        "pushed",
 			},
 		},
+		{
+			name: "with topic",
+			cli:  "--topic cli",
+			wants: ListOptions{
+				Limit:       30,
+				Owner:       "",
+				Visibility:  "",
+				Fork:        false,
+				Source:      false,
+				Language:    "",
+				Topic:       "cli",
+				Archived:    false,
+				NonArchived: false,
+				Sort:        "pushed",
+			},
+		},
+		{
+			name: "with sort",
+			cli:  "--sort created",
+			wants: ListOptions{
+				Limit:       30,
+				Owner:       "",
+				Visibility:  "",
+				Fork:        false,
+				Source:      false,
+				Language:    "",
+				Archived:    false,
+				NonArchived: false,
+				Sort:        "created",
+			},
+		},
+		{
+			name:     "invalid sort",
+			cli:      "--sort bogus",
+			wantsErr: `invalid sort: "bogus". Expected one of pushed, created, or name`,
+		},
 		{
 			name:     "no public and private",
 			cli:      "--public --private",
@@ -223,6 +269,8 @@ func TestNewCmdList(t *testing.T) {
 			assert.Equal(t, tt.wants.Source, gotOpts.Source)
 			assert.Equal(t, tt.wants.Archived, gotOpts.Archived)
 			assert.Equal(t, tt.wants.NonArchived, gotOpts.NonArchived)
+			assert.Equal(t, tt.wants.Topic, gotOpts.Topic)
+			assert.Equal(t, tt.wants.Sort, gotOpts.Sort)
 		})
 	}
 }
@@ -0,0 +1,66 @@
+package permissions
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+var PermissionsFields = []string{
+	"permissions",
+	"visibility",
+	"hasIssues",
+	"hasProjects",
+}
+
+type RepoPermissions struct {
+	Permissions struct {
+		Admin    bool `json:"admin"`
+		Maintain bool `json:"maintain"`
+		Push     bool `json:"push"`
+		Triage   bool `json:"triage"`
+		Pull     bool `json:"pull"`
+	} `json:"permissions"`
+	Visibility  string `json:"visibility"`
+	HasIssues   bool   `json:"has_issues"`
+	HasProjects bool   `json:"has_projects"`
+}
+
+func fetchPermissions(client *api.Client, repo ghrepo.Interface) (*RepoPermissions, error) {
+	var result RepoPermissions
+	path := fmt.Sprintf("repos/%s", ghrepo.FullName(repo))
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (p *RepoPermissions) ExportData(fields []string) *map[string]interface{} {
+	v := reflect.ValueOf(p).Elem()
+	fieldByName := func(v reflect.Value, field string) reflect.Value {
+		return v.FieldByNameFunc(func(s string) bool {
+			return strings.EqualFold(field, s)
+		})
+	}
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "permissions":
+			data[f] = map[string]interface{}{
+				"admin":    p.Permissions.Admin,
+				"maintain": p.Permissions.Maintain,
+				"push":     p.Permissions.Push,
+				"triage":   p.Permissions.Triage,
+				"pull":     p.Permissions.Pull,
+			}
+		default:
+			sf := fieldByName(v, f)
+			data[f] = sf.Interface()
+		}
+	}
+	return &data
+}
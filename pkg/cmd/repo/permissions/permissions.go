@@ -0,0 +1,108 @@
+package permissions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type PermissionsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
+
+	RepoArg string
+}
+
+func NewCmdPermissions(f *cmdutil.Factory, runF func(*PermissionsOptions) error) *cobra.Command {
+	opts := &PermissionsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "permissions [<repository>]",
+		Short: "Show your permissions for a repository",
+		Long: `Display the current user's permission level on a repository.
+
+With no argument, the repository for the current directory is used.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+			if runF != nil {
+				return runF(opts)
+			}
+			return permissionsRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, PermissionsFields)
+
+	return cmd
+}
+
+func permissionsRun(opts *PermissionsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var toView ghrepo.Interface
+	if opts.RepoArg == "" {
+		toView, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	} else {
+		toView, err = ghrepo.FromFullName(opts.RepoArg)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	perms, err := fetchPermissions(apiClient, toView)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO.Out, perms, opts.IO.ColorEnabled())
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := utils.NewTablePrinter(opts.IO)
+
+	addBoolRow := func(label string, value bool) {
+		tp.AddField(label, nil, nil)
+		if value {
+			tp.AddField("true", nil, cs.Green)
+		} else {
+			tp.AddField("false", nil, cs.Gray)
+		}
+		tp.EndRow()
+	}
+
+	addBoolRow("admin", perms.Permissions.Admin)
+	addBoolRow("maintain", perms.Permissions.Maintain)
+	addBoolRow("push", perms.Permissions.Push)
+	addBoolRow("triage", perms.Permissions.Triage)
+	addBoolRow("pull", perms.Permissions.Pull)
+	tp.AddField("visibility", nil, nil)
+	tp.AddField(perms.Visibility, nil, nil)
+	tp.EndRow()
+	addBoolRow("has_issues", perms.HasIssues)
+	addBoolRow("has_projects", perms.HasProjects)
+
+	return tp.Render()
+}
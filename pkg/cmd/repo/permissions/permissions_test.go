@@ -0,0 +1,121 @@
+package permissions
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdPermissions(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    PermissionsOptions
+		wantErr string
+	}{
+		{
+			name: "no arguments",
+			args: "",
+			want: PermissionsOptions{
+				RepoArg: "",
+			},
+		},
+		{
+			name: "repo argument",
+			args: "OWNER/REPO",
+			want: PermissionsOptions{
+				RepoArg: "OWNER/REPO",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *PermissionsOptions
+			cmd := NewCmdPermissions(f, func(o *PermissionsOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.RepoArg, opts.RepoArg)
+		})
+	}
+}
+
+func Test_permissionsRun(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO"),
+		httpmock.StringResponse(`{
+			"permissions": {
+				"admin": false,
+				"maintain": true,
+				"push": true,
+				"triage": true,
+				"pull": true
+			},
+			"visibility": "public",
+			"has_issues": true,
+			"has_projects": false
+		}`),
+	)
+
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(false)
+
+	opts := &PermissionsOptions{
+		IO: io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	err := permissionsRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		admin	false
+		maintain	true
+		push	true
+		triage	true
+		pull	true
+		visibility	public
+		has_issues	true
+		has_projects	false
+	`), stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
@@ -2,13 +2,21 @@ package repo
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	repoArchiveCmd "github.com/cli/cli/pkg/cmd/repo/archive"
 	repoCloneCmd "github.com/cli/cli/pkg/cmd/repo/clone"
 	repoCreateCmd "github.com/cli/cli/pkg/cmd/repo/create"
 	creditsCmd "github.com/cli/cli/pkg/cmd/repo/credits"
+	deployKeyCmd "github.com/cli/cli/pkg/cmd/repo/deploykey"
+	repoDispatchCmd "github.com/cli/cli/pkg/cmd/repo/dispatch"
 	repoForkCmd "github.com/cli/cli/pkg/cmd/repo/fork"
 	gardenCmd "github.com/cli/cli/pkg/cmd/repo/garden"
+	repoLanguageStatsCmd "github.com/cli/cli/pkg/cmd/repo/languagestats"
 	repoListCmd "github.com/cli/cli/pkg/cmd/repo/list"
+	repoPermissionsCmd "github.com/cli/cli/pkg/cmd/repo/permissions"
+	repoTrafficCmd "github.com/cli/cli/pkg/cmd/repo/traffic"
+	repoUnarchiveCmd "github.com/cli/cli/pkg/cmd/repo/unarchive"
 	repoViewCmd "github.com/cli/cli/pkg/cmd/repo/view"
+	webhookCmd "github.com/cli/cli/pkg/cmd/repo/webhook"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -39,7 +47,15 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(repoCreateCmd.NewCmdCreate(f, nil))
 	cmd.AddCommand(repoListCmd.NewCmdList(f, nil))
 	cmd.AddCommand(creditsCmd.NewCmdRepoCredits(f, nil))
+	cmd.AddCommand(repoPermissionsCmd.NewCmdPermissions(f, nil))
 	cmd.AddCommand(gardenCmd.NewCmdGarden(f, nil))
+	cmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
+	cmd.AddCommand(webhookCmd.NewCmdWebhook(f))
+	cmd.AddCommand(repoDispatchCmd.NewCmdDispatch(f, nil))
+	cmd.AddCommand(repoArchiveCmd.NewCmdArchive(f, nil))
+	cmd.AddCommand(repoUnarchiveCmd.NewCmdUnarchive(f, nil))
+	cmd.AddCommand(repoLanguageStatsCmd.NewCmdLanguageStats(f, nil))
+	cmd.AddCommand(repoTrafficCmd.NewCmdTraffic(f, nil))
 
 	return cmd
 }
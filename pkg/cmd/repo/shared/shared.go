@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// RepoArg resolves a repository selector provided as a command-line argument
+// into a ghrepo.Interface, defaulting to baseRepoFn when repoArg is empty.
+// A repoArg without an owner is resolved against the authenticated user.
+func RepoArg(httpClient *http.Client, baseRepoFn func() (ghrepo.Interface, error), repoArg string) (ghrepo.Interface, error) {
+	if repoArg == "" {
+		return baseRepoFn()
+	}
+
+	repoSelector := repoArg
+	if !strings.Contains(repoSelector, "/") {
+		apiClient := api.NewClientFromHTTP(httpClient)
+		currentUser, err := api.CurrentLoginName(apiClient, ghinstance.Default())
+		if err != nil {
+			return nil, err
+		}
+		repoSelector = currentUser + "/" + repoSelector
+	}
+
+	repo, err := ghrepo.FromFullName(repoSelector)
+	if err != nil {
+		return nil, fmt.Errorf("argument error: %w", err)
+	}
+	return repo, nil
+}
@@ -0,0 +1,288 @@
+package traffic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/repo/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type TrafficOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg string
+
+	Views        bool
+	Clones       bool
+	Referrers    bool
+	PopularPaths bool
+	JSON         bool
+}
+
+type dailyCount struct {
+	Timestamp string `json:"timestamp"`
+	Count     int    `json:"count"`
+	Uniques   int    `json:"uniques"`
+}
+
+type trafficSummary struct {
+	Count   int          `json:"count"`
+	Uniques int          `json:"uniques"`
+	Daily   []dailyCount `json:"daily"`
+}
+
+type popularPath struct {
+	Path    string `json:"path"`
+	Title   string `json:"title"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+type popularReferrer struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+func NewCmdTraffic(f *cmdutil.Factory, runF func(*TrafficOptions) error) *cobra.Command {
+	opts := &TrafficOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "traffic [<repository>]",
+		Short: "Show repository traffic statistics",
+		Long: heredoc.Doc(`
+			Show a repository's views and clones over the last 14 days, along with its
+			most popular referrers and content paths.
+
+			With no argument, the repository for the current directory is used.
+
+			With none of --views, --clones, --referrers, or --popular-paths, views and
+			clones are shown.
+
+			Viewing traffic data requires admin access to the repository.
+		`),
+		Example: heredoc.Doc(`
+			$ gh repo traffic
+			$ gh repo traffic cli/cli
+			$ gh repo traffic --referrers --popular-paths
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if !opts.Views && !opts.Clones && !opts.Referrers && !opts.PopularPaths {
+				opts.Views = true
+				opts.Clones = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return trafficRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Views, "views", false, "Show daily page views")
+	cmd.Flags().BoolVar(&opts.Clones, "clones", false, "Show daily git clones")
+	cmd.Flags().BoolVar(&opts.Referrers, "referrers", false, "Show the top referring sites")
+	cmd.Flags().BoolVar(&opts.PopularPaths, "popular-paths", false, "Show the most popular content paths")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output the requested data as JSON")
+
+	return cmd
+}
+
+func trafficRun(opts *TrafficOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := shared.RepoArg(httpClient, opts.BaseRepo, opts.RepoArg)
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	result := map[string]interface{}{}
+
+	if opts.Views {
+		views, err := getTrafficSummary(apiClient, repo, "views")
+		if err != nil {
+			return err
+		}
+		result["views"] = views
+	}
+
+	if opts.Clones {
+		clones, err := getTrafficSummary(apiClient, repo, "clones")
+		if err != nil {
+			return err
+		}
+		result["clones"] = clones
+	}
+
+	if opts.Referrers {
+		var referrers []popularReferrer
+		if err := apiClient.REST(repo.RepoHost(), "GET", fmt.Sprintf("repos/%s/%s/traffic/popular/referrers", repo.RepoOwner(), repo.RepoName()), nil, &referrers); err != nil {
+			return trafficError(err)
+		}
+		result["referrers"] = referrers
+	}
+
+	if opts.PopularPaths {
+		var paths []popularPath
+		if err := apiClient.REST(repo.RepoHost(), "GET", fmt.Sprintf("repos/%s/%s/traffic/popular/paths", repo.RepoOwner(), repo.RepoName()), nil, &paths); err != nil {
+			return trafficError(err)
+		}
+		result["popularPaths"] = paths
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(opts.IO.Out)
+		return enc.Encode(result)
+	}
+
+	cs := opts.IO.ColorScheme()
+	isTerminal := opts.IO.IsStdoutTTY()
+
+	if opts.Views {
+		printSummary(opts.IO, cs, isTerminal, "Views", result["views"].(*trafficSummary))
+	}
+
+	if opts.Clones {
+		printSummary(opts.IO, cs, isTerminal, "Clones", result["clones"].(*trafficSummary))
+	}
+
+	if opts.Referrers {
+		printReferrers(opts.IO, isTerminal, result["referrers"].([]popularReferrer))
+	}
+
+	if opts.PopularPaths {
+		printPopularPaths(opts.IO, isTerminal, result["popularPaths"].([]popularPath))
+	}
+
+	return nil
+}
+
+func getTrafficSummary(apiClient *api.Client, repo ghrepo.Interface, kind string) (*trafficSummary, error) {
+	var raw struct {
+		Count   int `json:"count"`
+		Uniques int `json:"uniques"`
+		Daily   []dailyCount
+	}
+	path := fmt.Sprintf("repos/%s/%s/traffic/%s", repo.RepoOwner(), repo.RepoName(), kind)
+
+	// the daily breakdown is returned under a key named after the resource ("views" or "clones")
+	var body map[string]json.RawMessage
+	if err := apiClient.REST(repo.RepoHost(), "GET", path, nil, &body); err != nil {
+		return nil, trafficError(err)
+	}
+	if err := json.Unmarshal(body["count"], &raw.Count); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body["uniques"], &raw.Uniques); err != nil {
+		return nil, err
+	}
+	if dailyRaw, ok := body[kind]; ok {
+		if err := json.Unmarshal(dailyRaw, &raw.Daily); err != nil {
+			return nil, err
+		}
+	}
+
+	return &trafficSummary{Count: raw.Count, Uniques: raw.Uniques, Daily: raw.Daily}, nil
+}
+
+func trafficError(err error) error {
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 403 {
+		return errors.New("you must be an admin of this repository to view its traffic data")
+	}
+	return err
+}
+
+func printSummary(io *iostreams.IOStreams, cs *iostreams.ColorScheme, isTerminal bool, label string, summary *trafficSummary) {
+	fmt.Fprintf(io.Out, "%s: %d total, %d unique\n", cs.Bold(label), summary.Count, summary.Uniques)
+
+	if isTerminal && len(summary.Daily) > 0 {
+		counts := make([]int, len(summary.Daily))
+		for i, d := range summary.Daily {
+			counts[i] = d.Count
+		}
+		fmt.Fprintf(io.Out, "%s\n", sparkline(counts))
+	} else {
+		for _, d := range summary.Daily {
+			fmt.Fprintf(io.Out, "%s\t%d\t%d\n", d.Timestamp, d.Count, d.Uniques)
+		}
+	}
+	fmt.Fprintln(io.Out)
+}
+
+func printReferrers(io *iostreams.IOStreams, isTerminal bool, referrers []popularReferrer) {
+	fmt.Fprintln(io.Out, "Top referrers:")
+	for _, r := range referrers {
+		if isTerminal {
+			fmt.Fprintf(io.Out, "  %-30s %5d visits  %5d unique\n", r.Referrer, r.Count, r.Uniques)
+		} else {
+			fmt.Fprintf(io.Out, "%s\t%d\t%d\n", r.Referrer, r.Count, r.Uniques)
+		}
+	}
+	fmt.Fprintln(io.Out)
+}
+
+func printPopularPaths(io *iostreams.IOStreams, isTerminal bool, paths []popularPath) {
+	fmt.Fprintln(io.Out, "Popular content:")
+	for _, p := range paths {
+		if isTerminal {
+			fmt.Fprintf(io.Out, "  %-40s %5d visits  %5d unique\n", p.Path, p.Count, p.Uniques)
+		} else {
+			fmt.Fprintf(io.Out, "%s\t%d\t%d\n", p.Path, p.Count, p.Uniques)
+		}
+	}
+}
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+func sparkline(counts []int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	min, max := counts[0], counts[0]
+	for _, c := range counts {
+		if c < min {
+			min = c
+		}
+		if c > max {
+			max = c
+		}
+	}
+
+	out := make([]rune, len(counts))
+	for i, c := range counts {
+		if max == min {
+			out[i] = sparkBlocks[0]
+			continue
+		}
+		idx := (c - min) * (len(sparkBlocks) - 1) / (max - min)
+		out[i] = sparkBlocks[idx]
+	}
+
+	return string(out)
+}
@@ -0,0 +1,209 @@
+package traffic
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdTraffic(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+		want TrafficOptions
+	}{
+		{
+			name: "no argument",
+			args: "",
+			want: TrafficOptions{
+				Views:  true,
+				Clones: true,
+			},
+		},
+		{
+			name: "repo argument",
+			args: "OWNER/REPO",
+			want: TrafficOptions{
+				RepoArg: "OWNER/REPO",
+				Views:   true,
+				Clones:  true,
+			},
+		},
+		{
+			name: "referrers flag",
+			args: "OWNER/REPO --referrers",
+			want: TrafficOptions{
+				RepoArg:   "OWNER/REPO",
+				Referrers: true,
+			},
+		},
+		{
+			name: "popular-paths flag",
+			args: "OWNER/REPO --popular-paths",
+			want: TrafficOptions{
+				RepoArg:      "OWNER/REPO",
+				PopularPaths: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *TrafficOptions
+			cmd := NewCmdTraffic(f, func(o *TrafficOptions) error {
+				opts = o
+				return nil
+			})
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.RepoArg, opts.RepoArg)
+			assert.Equal(t, tt.want.Views, opts.Views)
+			assert.Equal(t, tt.want.Clones, opts.Clones)
+			assert.Equal(t, tt.want.Referrers, opts.Referrers)
+			assert.Equal(t, tt.want.PopularPaths, opts.PopularPaths)
+		})
+	}
+}
+
+func Test_trafficRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		isTTY      bool
+		opts       TrafficOptions
+		stub       func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name:  "views and clones, non-tty",
+			isTTY: false,
+			opts: TrafficOptions{
+				RepoArg: "OWNER/REPO",
+				Views:   true,
+				Clones:  true,
+			},
+			stub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+					httpmock.StringResponse(`{"count": 10, "uniques": 5, "views": [{"timestamp": "2021-01-01T00:00:00Z", "count": 10, "uniques": 5}]}`))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/traffic/clones"),
+					httpmock.StringResponse(`{"count": 2, "uniques": 1, "clones": [{"timestamp": "2021-01-01T00:00:00Z", "count": 2, "uniques": 1}]}`))
+			},
+			wantStdout: "Views: 10 total, 5 unique\n2021-01-01T00:00:00Z\t10\t5\n\nClones: 2 total, 1 unique\n2021-01-01T00:00:00Z\t2\t1\n\n",
+		},
+		{
+			name:  "referrers, non-tty",
+			isTTY: false,
+			opts: TrafficOptions{
+				RepoArg:   "OWNER/REPO",
+				Referrers: true,
+			},
+			stub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/referrers"),
+					httpmock.StringResponse(`[{"referrer": "google.com", "count": 10, "uniques": 5}]`))
+			},
+			wantStdout: "Top referrers:\ngoogle.com\t10\t5\n\n",
+		},
+		{
+			name:  "popular paths, non-tty",
+			isTTY: false,
+			opts: TrafficOptions{
+				RepoArg:      "OWNER/REPO",
+				PopularPaths: true,
+			},
+			stub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/paths"),
+					httpmock.StringResponse(`[{"path": "/", "title": "root", "count": 10, "uniques": 5}]`))
+			},
+			wantStdout: "Popular content:\n/\t10\t5\n",
+		},
+		{
+			name:  "json output",
+			isTTY: false,
+			opts: TrafficOptions{
+				RepoArg: "OWNER/REPO",
+				Views:   true,
+				JSON:    true,
+			},
+			stub: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+					httpmock.StringResponse(`{"count": 10, "uniques": 5, "views": [{"timestamp": "2021-01-01T00:00:00Z", "count": 10, "uniques": 5}]}`))
+			},
+			wantStdout: "{\"views\":{\"count\":10,\"uniques\":5,\"daily\":[{\"timestamp\":\"2021-01-01T00:00:00Z\",\"count\":10,\"uniques\":5}]}}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+
+			fakeHTTP := &httpmock.Registry{}
+			tt.stub(fakeHTTP)
+			defer fakeHTTP.Verify(t)
+
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: fakeHTTP}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := trafficRun(&tt.opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func Test_trafficRun_forbidden(t *testing.T) {
+	io, _, _, _ := iostreams.Test()
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+		httpmock.StatusStringResponse(403, `{"message": "Must have admin rights to Repository."}`))
+	defer fakeHTTP.Verify(t)
+
+	opts := &TrafficOptions{
+		IO:      io,
+		RepoArg: "OWNER/REPO",
+		Views:   true,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := trafficRun(opts)
+	require.EqualError(t, err, "you must be an admin of this repository to view its traffic data")
+}
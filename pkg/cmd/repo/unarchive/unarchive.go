@@ -0,0 +1,76 @@
+package unarchive
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/repo/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UnarchiveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RepoArg string
+}
+
+func NewCmdUnarchive(f *cmdutil.Factory, runF func(*UnarchiveOptions) error) *cobra.Command {
+	opts := &UnarchiveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unarchive [<repository>]",
+		Short: "Unarchive a repository",
+		Long: `Unarchive a GitHub repository.
+
+With no argument, the repository for the current directory is unarchived.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return unarchiveRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func unarchiveRun(opts *UnarchiveOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	toUnarchive, err := shared.RepoArg(httpClient, opts.BaseRepo, opts.RepoArg)
+	if err != nil {
+		return err
+	}
+
+	fullName := ghrepo.FullName(toUnarchive)
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	if err := api.EditRepoArchival(apiClient, toUnarchive, false); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Unarchived %s\n", cs.SuccessIcon(), fullName)
+	}
+
+	return nil
+}
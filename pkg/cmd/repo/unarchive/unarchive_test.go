@@ -0,0 +1,103 @@
+package unarchive
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewCmdUnarchive(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    UnarchiveOptions
+		wantErr string
+	}{
+		{
+			name: "no argument",
+			args: "",
+			want: UnarchiveOptions{
+				RepoArg: "",
+			},
+		},
+		{
+			name: "repo argument",
+			args: "OWNER/REPO",
+			want: UnarchiveOptions{
+				RepoArg: "OWNER/REPO",
+			},
+		},
+		{
+			name:    "too many arguments",
+			args:    "OWNER/REPO extra",
+			wantErr: "accepts at most 1 arg(s), received 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var opts *UnarchiveOptions
+			cmd := NewCmdUnarchive(f, func(o *UnarchiveOptions) error {
+				opts = o
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.RepoArg, opts.RepoArg)
+		})
+	}
+}
+
+func Test_unarchiveRun(t *testing.T) {
+	io, _, stdout, _ := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStdinTTY(true)
+	io.SetStderrTTY(true)
+
+	fakeHTTP := &httpmock.Registry{}
+	fakeHTTP.Register(httpmock.REST("PATCH", "repos/OWNER/REPO"), httpmock.StatusStringResponse(200, "{}"))
+
+	opts := &UnarchiveOptions{
+		RepoArg: "OWNER/REPO",
+		IO:      io,
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: fakeHTTP}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		},
+	}
+
+	err := unarchiveRun(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Unarchived OWNER/REPO\n", stdout.String())
+}
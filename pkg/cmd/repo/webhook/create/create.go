@@ -0,0 +1,143 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName     string
+	URL         string
+	ContentType string
+	Events      []string
+	Secret      string
+	Active      bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+		Active:     true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a webhook",
+		Long:  "Create a webhook for a repository or organization",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.URL == "" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--url` required")}
+			}
+			if opts.ContentType != "json" && opts.ContentType != "form" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--content-type` must be \"json\" or \"form\"")}
+			}
+			if len(opts.Events) == 0 {
+				return &cmdutil.FlagError{Err: fmt.Errorf("`--events` required")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Create a webhook for an organization")
+	cmd.Flags().StringVar(&opts.URL, "url", "", "The URL to which payloads will be delivered")
+	cmd.Flags().StringVar(&opts.ContentType, "content-type", "form", "The media type used to serialize payloads: {json|form}")
+	cmd.Flags().StringSliceVar(&opts.Events, "events", nil, "The events that trigger the webhook")
+	cmd.Flags().StringVar(&opts.Secret, "secret", "", "A secret used to sign payload deliveries")
+	cmd.Flags().BoolVar(&opts.Active, "active", true, "Deliver payloads for this webhook")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	secret, err := getSecret(opts)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	orgName := opts.OrgName
+
+	var host, path, target string
+	if orgName == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		host = repo.RepoHost()
+		path = fmt.Sprintf("repos/%s/hooks", ghrepo.FullName(repo))
+		target = ghrepo.FullName(repo)
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+		path = fmt.Sprintf("orgs/%s/hooks", orgName)
+		target = orgName
+	}
+
+	err = hookCreate(apiClient, host, path, opts.URL, opts.ContentType, opts.Events, secret, opts.Active)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Webhook created for %s\n", cs.SuccessIcon(), target)
+	}
+
+	return nil
+}
+
+func getSecret(opts *CreateOptions) (string, error) {
+	if opts.Secret != "" {
+		return opts.Secret, nil
+	}
+
+	if !opts.IO.CanPrompt() {
+		return "", nil
+	}
+
+	var secret string
+	err := prompt.SurveyAskOne(&survey.Password{
+		Message: "Webhook secret (leave blank for none)",
+	}, &secret)
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintln(opts.IO.Out)
+
+	return secret, nil
+}
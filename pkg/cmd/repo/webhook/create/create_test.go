@@ -0,0 +1,118 @@
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no url",
+			cli:     `--events push`,
+			wantErr: "`--url` required",
+		},
+		{
+			name:    "no events",
+			cli:     `--url https://example.com/hook`,
+			wantErr: "`--events` required",
+		},
+		{
+			name:    "invalid content type",
+			cli:     `--url https://example.com/hook --events push --content-type xml`,
+			wantErr: "`--content-type` must be \"json\" or \"form\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error { return nil })
+			cmd.SetArgs(argv)
+			_, err = cmd.ExecuteC()
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_createRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/hooks"),
+		httpmock.StringResponse(`{}`))
+
+	err := createRun(&CreateOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.New("OWNER", "REPO"), nil
+		},
+		URL:         "https://example.com/hook",
+		ContentType: "json",
+		Events:      []string{"push"},
+		Secret:      "s3cr3t",
+		Active:      true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Webhook created for OWNER/REPO\n", stderr.String())
+}
+
+func Test_createRun_org(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	io.SetStdoutTTY(true)
+	io.SetStderrTTY(true)
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("POST", "orgs/my-org/hooks"),
+		httpmock.StringResponse(`{}`))
+
+	err := createRun(&CreateOptions{
+		IO: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		OrgName:     "my-org",
+		URL:         "https://example.com/hook",
+		ContentType: "form",
+		Events:      []string{"push"},
+		Active:      true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "✓ Webhook created for my-org\n", stderr.String())
+}
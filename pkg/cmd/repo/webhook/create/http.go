@@ -0,0 +1,32 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cli/cli/api"
+)
+
+func hookCreate(client *api.Client, host, path, url, contentType string, events []string, secret string, active bool) error {
+	config := map[string]interface{}{
+		"url":          url,
+		"content_type": contentType,
+	}
+	if secret != "" {
+		config["secret"] = secret
+	}
+
+	payload := map[string]interface{}{
+		"name":   "web",
+		"active": active,
+		"events": events,
+		"config": config,
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return client.REST(host, "POST", path, bytes.NewReader(payloadBytes), nil)
+}
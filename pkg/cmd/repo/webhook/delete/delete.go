@@ -0,0 +1,97 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName string
+	HookID  string
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <hook-id>",
+		Short: "Delete a webhook",
+		Long:  "Delete a webhook from a repository or organization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			opts.HookID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Delete a webhook from an organization")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	orgName := opts.OrgName
+
+	var host, path, target string
+	if orgName == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		host = repo.RepoHost()
+		path = fmt.Sprintf("repos/%s/hooks/%s", ghrepo.FullName(repo), opts.HookID)
+		target = ghrepo.FullName(repo)
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+		path = fmt.Sprintf("orgs/%s/hooks/%s", orgName, opts.HookID)
+		target = orgName
+	}
+
+	err = hookDelete(apiClient, host, path)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook %s: %w", opts.HookID, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Webhook %s deleted from %s\n", cs.SuccessIconWithColor(cs.Red), opts.HookID, target)
+	}
+
+	return nil
+}
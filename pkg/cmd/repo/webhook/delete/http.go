@@ -0,0 +1,9 @@
+package delete
+
+import (
+	"github.com/cli/cli/api"
+)
+
+func hookDelete(client *api.Client, host, path string) error {
+	return client.REST(host, "DELETE", path, nil, nil)
+}
@@ -0,0 +1,27 @@
+package list
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/api"
+)
+
+type hook struct {
+	ID     int
+	Active bool
+	Events []string
+	Config struct {
+		URL string `json:"url"`
+	}
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func hookList(client *api.Client, host, path string) ([]hook, error) {
+	var hooks []hook
+	err := client.REST(host, "GET", fmt.Sprintf("%s?per_page=100", path), nil, &hooks)
+	if err != nil {
+		return nil, err
+	}
+	return hooks, nil
+}
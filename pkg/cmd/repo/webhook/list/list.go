@@ -0,0 +1,111 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	OrgName string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks",
+		Long:  "List webhooks for a repository or organization",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "List webhooks for an organization")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	orgName := opts.OrgName
+
+	var host, path, target string
+	if orgName == "" {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		host = repo.RepoHost()
+		path = fmt.Sprintf("repos/%s/hooks", ghrepo.FullName(repo))
+		target = ghrepo.FullName(repo)
+	} else {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, err = cfg.DefaultHost()
+		if err != nil {
+			return err
+		}
+		path = fmt.Sprintf("orgs/%s/hooks", orgName)
+		target = orgName
+	}
+
+	hooks, err := hookList(apiClient, host, path)
+	if err != nil {
+		return err
+	}
+
+	if len(hooks) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No webhooks found for %s\n", target)
+		return cmdutil.SilentError
+	}
+
+	t := utils.NewTablePrinter(opts.IO)
+	cs := opts.IO.ColorScheme()
+
+	for _, h := range hooks {
+		t.AddField(fmt.Sprintf("%d", h.ID), nil, nil)
+		t.AddField(h.Config.URL, nil, nil)
+		t.AddField(strings.Join(h.Events, ", "), nil, nil)
+		active := "active"
+		if !h.Active {
+			active = "inactive"
+		}
+		t.AddField(active, nil, cs.Gray)
+		t.EndRow()
+	}
+
+	return t.Render()
+}
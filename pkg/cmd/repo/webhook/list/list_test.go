@@ -0,0 +1,110 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       *ListOptions
+		httpStubs  func(*httpmock.Registry)
+		isTTY      bool
+		wantStdout string
+		wantStderr string
+		wantErr    bool
+	}{
+		{
+			name: "list tty",
+			opts: &ListOptions{},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/hooks"),
+					httpmock.StringResponse(`[
+						{
+							"id": 1234,
+							"active": true,
+							"events": ["push", "pull_request"],
+							"config": {"url": "https://example.com/hook"}
+						}
+					]`),
+				)
+			},
+			isTTY:      true,
+			wantStdout: "1234  https://example.com/hook  push, pull_request  active\n",
+		},
+		{
+			name: "no webhooks",
+			opts: &ListOptions{},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/hooks"),
+					httpmock.StringResponse(`[]`),
+				)
+			},
+			wantStderr: "No webhooks found for OWNER/REPO\n",
+			wantErr:    true,
+		},
+		{
+			name: "org webhooks",
+			opts: &ListOptions{OrgName: "my-org"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "orgs/my-org/hooks"),
+					httpmock.StringResponse(`[
+						{
+							"id": 5678,
+							"active": false,
+							"events": ["push"],
+							"config": {"url": "https://example.com/org-hook"}
+						}
+					]`),
+				)
+			},
+			isTTY:      true,
+			wantStdout: "5678  https://example.com/org-hook  push  inactive\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(tt.isTTY)
+			io.SetStdinTTY(tt.isTTY)
+			io.SetStderrTTY(tt.isTTY)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			tt.httpStubs(reg)
+
+			opts := tt.opts
+			opts.IO = io
+			opts.Config = func() (config.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err := listRun(opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
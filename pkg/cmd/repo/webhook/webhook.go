@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	cmdCreate "github.com/cli/cli/pkg/cmd/repo/webhook/create"
+	cmdDelete "github.com/cli/cli/pkg/cmd/repo/webhook/delete"
+	cmdList "github.com/cli/cli/pkg/cmd/repo/webhook/list"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdWebhook(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook <command>",
+		Short: "Manage webhooks",
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(cmdList.NewCmdList(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
+	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+
+	return cmd
+}
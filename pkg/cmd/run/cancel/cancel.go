@@ -0,0 +1,204 @@
+package cancel
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/run/shared"
+	workflowShared "github.com/cli/cli/pkg/cmd/workflow/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/spf13/cobra"
+)
+
+const cancelAllLimit = 100
+
+type CancelOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	RunID            string
+	All              bool
+	WorkflowSelector string
+	SkipConfirm      bool
+}
+
+func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Command {
+	opts := &CancelOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cancel [<run-id>]",
+		Short: "Cancel a workflow run",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.RunID = args[0]
+			}
+
+			if opts.All && opts.RunID != "" {
+				return &cmdutil.FlagError{Err: errors.New("specify either a run ID or `--all`, not both")}
+			}
+			if !opts.All && opts.RunID == "" {
+				return &cmdutil.FlagError{Err: errors.New("a run ID is required when `--all` is not used")}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return cancelRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.All, "all", "a", false, "Cancel the in-progress and queued runs among the 50 most recent runs, or among the 100 most recent runs of the `--workflow` workflow")
+	cmd.Flags().StringVarP(&opts.WorkflowSelector, "workflow", "w", "", "Filter runs by workflow when using `--all`")
+	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+func cancelRun(opts *CancelOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	if opts.All {
+		return cancelAllRuns(opts, client, repo)
+	}
+
+	opts.IO.StartProgressIndicator()
+	run, err := shared.GetRun(client, repo, opts.RunID)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get run: %w", err)
+	}
+
+	err = cancelWorkflowRun(client, repo, run.ID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel run: %w", err)
+	}
+
+	if opts.IO.CanPrompt() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Canceled run %s\n",
+			cs.SuccessIcon(),
+			cs.Cyanf("%d", run.ID))
+	}
+
+	return nil
+}
+
+func cancelAllRuns(opts *CancelOptions, client *api.Client, repo ghrepo.Interface) error {
+	isIncomplete := func(run shared.Run) bool {
+		return run.Status != shared.Completed
+	}
+
+	opts.IO.StartProgressIndicator()
+	var runs []shared.Run
+	var err error
+	if opts.WorkflowSelector != "" {
+		var workflow *workflowShared.Workflow
+		workflow, err = workflowShared.ResolveWorkflow(opts.IO, client, repo, false, opts.WorkflowSelector, []workflowShared.WorkflowState{workflowShared.Active})
+		if err == nil {
+			var allRuns []shared.Run
+			allRuns, err = shared.GetRunsByWorkflow(client, repo, cancelAllLimit, workflow.ID, "", "", "")
+			for _, run := range allRuns {
+				if isIncomplete(run) {
+					runs = append(runs, run)
+				}
+			}
+		}
+	} else {
+		runs, err = shared.GetRunsWithFilter(client, repo, cancelAllLimit, isIncomplete)
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get runs: %w", err)
+	}
+
+	if len(runs) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "no in-progress or queued runs found")
+		return nil
+	}
+
+	if !opts.SkipConfirm && opts.IO.CanPrompt() {
+		var confirmed bool
+		err := prompt.SurveyAskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Cancel %d in-progress or queued run(s) in %s?", len(runs), ghrepo.FullName(repo)),
+			Default: true,
+		}, &confirmed)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	type cancelResult struct {
+		run          shared.Run
+		alreadyEnded bool
+		err          error
+	}
+
+	results := make([]cancelResult, len(runs))
+	var wg sync.WaitGroup
+	for i, run := range runs {
+		wg.Add(1)
+		go func(i int, run shared.Run) {
+			defer wg.Done()
+			err := cancelWorkflowRun(client, repo, run.ID)
+			var httpError api.HTTPError
+			if errors.As(err, &httpError) && httpError.StatusCode == 409 {
+				results[i] = cancelResult{run: run, alreadyEnded: true}
+				return
+			}
+			results[i] = cancelResult{run: run, err: err}
+		}(i, run)
+	}
+	wg.Wait()
+
+	cs := opts.IO.ColorScheme()
+	var failed int
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			failed++
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to cancel run %d: %s\n", cs.FailureIcon(), result.run.ID, result.err)
+		case result.alreadyEnded:
+			fmt.Fprintf(opts.IO.Out, "%s Run %d had already finished\n", cs.Gray("-"), result.run.ID)
+		default:
+			fmt.Fprintf(opts.IO.Out, "%s Canceled run %d\n", cs.SuccessIcon(), result.run.ID)
+		}
+	}
+
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+func cancelWorkflowRun(client *api.Client, repo ghrepo.Interface, runID int64) error {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/cancel", ghrepo.FullName(repo), runID)
+	return client.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
@@ -0,0 +1,192 @@
+package cancel
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/run/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCancel(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		tty      bool
+		wants    CancelOptions
+		wantsErr bool
+	}{
+		{
+			name:     "blank",
+			wantsErr: true,
+		},
+		{
+			name: "with arg",
+			cli:  "1234",
+			wants: CancelOptions{
+				RunID: "1234",
+			},
+		},
+		{
+			name: "with all",
+			cli:  "--all",
+			wants: CancelOptions{
+				All: true,
+			},
+		},
+		{
+			name:     "arg and all",
+			cli:      "1234 --all",
+			wantsErr: true,
+		},
+		{
+			name: "all with workflow and yes",
+			cli:  "--all --workflow build.yml --yes",
+			wants: CancelOptions{
+				All:              true,
+				WorkflowSelector: "build.yml",
+				SkipConfirm:      true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdinTTY(tt.tty)
+			io.SetStdoutTTY(tt.tty)
+
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *CancelOptions
+			cmd := NewCmdCancel(f, func(opts *CancelOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(ioutil.Discard)
+			cmd.SetErr(ioutil.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.wants.All, gotOpts.All)
+			assert.Equal(t, tt.wants.WorkflowSelector, gotOpts.WorkflowSelector)
+			assert.Equal(t, tt.wants.SkipConfirm, gotOpts.SkipConfirm)
+		})
+	}
+}
+
+func TestCancelRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		httpStubs func(*httpmock.Registry)
+		opts      *CancelOptions
+		tty       bool
+		wantErr   bool
+		wantOut   string
+	}{
+		{
+			name: "single run",
+			tty:  true,
+			opts: &CancelOptions{
+				RunID: "1234",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/cancel"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Canceled run 1234\n",
+		},
+		{
+			name: "all, none in progress",
+			tty:  true,
+			opts: &CancelOptions{
+				All: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{shared.SuccessfulRun},
+					}))
+			},
+			wantOut: "",
+		},
+		{
+			name: "all, skip confirm",
+			tty:  true,
+			opts: &CancelOptions{
+				All:         true,
+				SkipConfirm: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							shared.TestRun("in progress", 2, shared.InProgress, ""),
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/2/cancel"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ Canceled run 2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+			io.SetStdinTTY(tt.tty)
+
+			tt.opts.IO = io
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+
+			err := cancelRun(tt.opts)
+			reg.Verify(t)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -69,6 +69,17 @@ func Test_NewCmdDownload(t *testing.T) {
 				DestinationDir: ".",
 			},
 		},
+		{
+			name:  "with patterns",
+			args:  `2345 -p "one-*" -p "two-*"`,
+			isTTY: true,
+			want: DownloadOptions{
+				RunID:          "2345",
+				DoPrompt:       false,
+				FilePatterns:   []string{"one-*", "two-*"},
+				DestinationDir: ".",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -112,6 +123,7 @@ func Test_NewCmdDownload(t *testing.T) {
 
 			assert.Equal(t, tt.want.RunID, opts.RunID)
 			assert.Equal(t, tt.want.Names, opts.Names)
+			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
 			assert.Equal(t, tt.want.DestinationDir, opts.DestinationDir)
 			assert.Equal(t, tt.want.DoPrompt, opts.DoPrompt)
 		})
@@ -199,7 +211,48 @@ func Test_runDownload(t *testing.T) {
 					},
 				}, nil)
 			},
-			wantErr: "no artifact matches any of the names provided",
+			wantErr: "no artifact matches any of the names or patterns provided",
+		},
+		{
+			name: "pattern match",
+			opts: DownloadOptions{
+				RunID:          "2345",
+				DestinationDir: ".",
+				FilePatterns:   []string{"artifact-*"},
+			},
+			mockAPI: func(p *mockPlatform) {
+				p.On("List", "2345").Return([]shared.Artifact{
+					{
+						Name:        "artifact-1",
+						DownloadURL: "http://download.com/artifact1.zip",
+						Expired:     false,
+					},
+					{
+						Name:        "other",
+						DownloadURL: "http://download.com/other.zip",
+						Expired:     false,
+					},
+				}, nil)
+				p.On("Download", "http://download.com/artifact1.zip", filepath.FromSlash("artifact-1")).Return(nil)
+			},
+		},
+		{
+			name: "no pattern matches",
+			opts: DownloadOptions{
+				RunID:          "2345",
+				DestinationDir: ".",
+				FilePatterns:   []string{"no-such-*"},
+			},
+			mockAPI: func(p *mockPlatform) {
+				p.On("List", "2345").Return([]shared.Artifact{
+					{
+						Name:        "artifact-1",
+						DownloadURL: "http://download.com/artifact1.zip",
+						Expired:     false,
+					},
+				}, nil)
+			},
+			wantErr: "no artifact matches any of the names or patterns provided",
 		},
 		{
 			name: "prompt to select artifact",
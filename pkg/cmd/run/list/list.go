@@ -23,17 +23,23 @@ type ListOptions struct {
 	IO         *iostreams.IOStreams
 	HttpClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
+	Branch     func() (string, error)
 
 	PlainOutput bool
 
 	Limit            int
 	WorkflowSelector string
+	Created          string
+	SelectedBranch   string
+	CurrentBranch    bool
+	Status           string
 }
 
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := &ListOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Branch:     f.Branch,
 	}
 
 	cmd := &cobra.Command{
@@ -51,6 +57,35 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: fmt.Errorf("invalid limit: %v", opts.Limit)}
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--branch` or `--current-branch`",
+				opts.SelectedBranch != "",
+				opts.CurrentBranch,
+			); err != nil {
+				return err
+			}
+
+			if opts.CurrentBranch {
+				currentBranch, err := opts.Branch()
+				if err != nil {
+					return fmt.Errorf("could not determine the current branch: %w", err)
+				}
+				opts.SelectedBranch = currentBranch
+			}
+
+			if opts.Status != "" {
+				validStatus := false
+				for _, s := range shared.AllStatuses {
+					if opts.Status == s {
+						validStatus = true
+						break
+					}
+				}
+				if !validStatus {
+					return &cmdutil.FlagError{Err: fmt.Errorf("invalid status: %s", opts.Status)}
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -61,6 +96,10 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultLimit, "Maximum number of runs to fetch")
 	cmd.Flags().StringVarP(&opts.WorkflowSelector, "workflow", "w", "", "Filter runs by workflow")
+	cmd.Flags().StringVar(&opts.Created, "created", "", "Filter runs by the `date` they were created, e.g. 2023-01-01..2023-01-31, >2023-01-01, or <2023-01-31")
+	cmd.Flags().StringVarP(&opts.SelectedBranch, "branch", "b", "", "Filter runs by branch")
+	cmd.Flags().BoolVarP(&opts.CurrentBranch, "current-branch", "c", false, "Filter runs by the current branch")
+	cmd.Flags().StringVarP(&opts.Status, "status", "s", "", "Filter runs by status: {queued|completed|in_progress|requested|waiting|action_required|cancelled|failure|neutral|skipped|stale|success|timed_out}")
 
 	return cmd
 }
@@ -86,10 +125,10 @@ func listRun(opts *ListOptions) error {
 		workflow, err = workflowShared.ResolveWorkflow(
 			opts.IO, client, baseRepo, false, opts.WorkflowSelector, states)
 		if err == nil {
-			runs, err = shared.GetRunsByWorkflow(client, baseRepo, opts.Limit, workflow.ID)
+			runs, err = shared.GetRunsByWorkflow(client, baseRepo, opts.Limit, workflow.ID, opts.Created, opts.SelectedBranch, opts.Status)
 		}
 	} else {
-		runs, err = shared.GetRuns(client, baseRepo, opts.Limit)
+		runs, err = shared.GetRunsWithCreatedFilter(client, baseRepo, opts.Limit, opts.Created, opts.SelectedBranch, opts.Status)
 	}
 	opts.IO.StopProgressIndicator()
 	if err != nil {
@@ -17,6 +17,33 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func matchCreatedQuery(created string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		if req.URL.Path != "/repos/OWNER/REPO/actions/runs" {
+			return false
+		}
+		return req.URL.Query().Get("created") == created
+	}
+}
+
+func matchBranchQuery(branch string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		if req.URL.Path != "/repos/OWNER/REPO/actions/runs" {
+			return false
+		}
+		return req.URL.Query().Get("branch") == branch
+	}
+}
+
+func matchStatusQuery(status string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		if req.URL.Path != "/repos/OWNER/REPO/actions/runs" {
+			return false
+		}
+		return req.URL.Query().Get("status") == status
+	}
+}
+
 func TestNewCmdList(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -51,6 +78,48 @@ func TestNewCmdList(t *testing.T) {
 				WorkflowSelector: "foo.yml",
 			},
 		},
+		{
+			name: "created",
+			cli:  "--created 2023-01-01..2023-01-31",
+			wants: ListOptions{
+				Limit:   defaultLimit,
+				Created: "2023-01-01..2023-01-31",
+			},
+		},
+		{
+			name: "branch",
+			cli:  "--branch trunk",
+			wants: ListOptions{
+				Limit:          defaultLimit,
+				SelectedBranch: "trunk",
+			},
+		},
+		{
+			name:     "branch and current-branch are mutually exclusive",
+			cli:      "--branch trunk --current-branch",
+			wantsErr: true,
+		},
+		{
+			name: "current-branch",
+			cli:  "--current-branch",
+			wants: ListOptions{
+				Limit:          defaultLimit,
+				SelectedBranch: "feature",
+			},
+		},
+		{
+			name: "status",
+			cli:  "--status failure",
+			wants: ListOptions{
+				Limit:  defaultLimit,
+				Status: "failure",
+			},
+		},
+		{
+			name:     "invalid status",
+			cli:      "--status bogus",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -61,6 +130,9 @@ func TestNewCmdList(t *testing.T) {
 
 			f := &cmdutil.Factory{
 				IOStreams: io,
+				Branch: func() (string, error) {
+					return "feature", nil
+				},
 			}
 
 			argv, err := shlex.Split(tt.cli)
@@ -83,6 +155,9 @@ func TestNewCmdList(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+			assert.Equal(t, tt.wants.Created, gotOpts.Created)
+			assert.Equal(t, tt.wants.SelectedBranch, gotOpts.SelectedBranch)
+			assert.Equal(t, tt.wants.Status, gotOpts.Status)
 		})
 	}
 }
@@ -180,6 +255,51 @@ func TestListRun(t *testing.T) {
 			wantOut:    "",
 			wantErrOut: "No runs found\n",
 		},
+		{
+			name: "created filter",
+			opts: &ListOptions{
+				Limit:   defaultLimit,
+				Created: "2023-01-01..2023-01-31",
+			},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					matchCreatedQuery("2023-01-01..2023-01-31"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: shared.TestRuns,
+					}))
+			},
+			wantOut: "STATUS  NAME         WORKFLOW     BRANCH  EVENT  ID    ELAPSED  AGE\nX       cool commit  timed out    trunk   push   1     4m34s    Feb 23, 2021\n-       cool commit  in progress  trunk   push   2     4m34s    Feb 23, 2021\n✓       cool commit  successful   trunk   push   3     4m34s    Feb 23, 2021\n✓       cool commit  cancelled    trunk   push   4     4m34s    Feb 23, 2021\nX       cool commit  failed       trunk   push   1234  4m34s    Feb 23, 2021\n✓       cool commit  neutral      trunk   push   6     4m34s    Feb 23, 2021\n✓       cool commit  skipped      trunk   push   7     4m34s    Feb 23, 2021\n-       cool commit  requested    trunk   push   8     4m34s    Feb 23, 2021\n-       cool commit  queued       trunk   push   9     4m34s    Feb 23, 2021\nX       cool commit  stale        trunk   push   10    4m34s    Feb 23, 2021\n\nFor details on a run, try: gh run view <run-id>\n",
+		},
+		{
+			name: "branch filter",
+			opts: &ListOptions{
+				Limit:          defaultLimit,
+				SelectedBranch: "trunk",
+			},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					matchBranchQuery("trunk"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: shared.TestRuns,
+					}))
+			},
+			wantOut: "STATUS  NAME         WORKFLOW     BRANCH  EVENT  ID    ELAPSED  AGE\nX       cool commit  timed out    trunk   push   1     4m34s    Feb 23, 2021\n-       cool commit  in progress  trunk   push   2     4m34s    Feb 23, 2021\n✓       cool commit  successful   trunk   push   3     4m34s    Feb 23, 2021\n✓       cool commit  cancelled    trunk   push   4     4m34s    Feb 23, 2021\nX       cool commit  failed       trunk   push   1234  4m34s    Feb 23, 2021\n✓       cool commit  neutral      trunk   push   6     4m34s    Feb 23, 2021\n✓       cool commit  skipped      trunk   push   7     4m34s    Feb 23, 2021\n-       cool commit  requested    trunk   push   8     4m34s    Feb 23, 2021\n-       cool commit  queued       trunk   push   9     4m34s    Feb 23, 2021\nX       cool commit  stale        trunk   push   10    4m34s    Feb 23, 2021\n\nFor details on a run, try: gh run view <run-id>\n",
+		},
+		{
+			name: "status filter",
+			opts: &ListOptions{
+				Limit:  defaultLimit,
+				Status: "failure",
+			},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					matchStatusQuery("failure"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: shared.TestRuns,
+					}))
+			},
+			wantOut: "STATUS  NAME         WORKFLOW     BRANCH  EVENT  ID    ELAPSED  AGE\nX       cool commit  timed out    trunk   push   1     4m34s    Feb 23, 2021\n-       cool commit  in progress  trunk   push   2     4m34s    Feb 23, 2021\n✓       cool commit  successful   trunk   push   3     4m34s    Feb 23, 2021\n✓       cool commit  cancelled    trunk   push   4     4m34s    Feb 23, 2021\nX       cool commit  failed       trunk   push   1234  4m34s    Feb 23, 2021\n✓       cool commit  neutral      trunk   push   6     4m34s    Feb 23, 2021\n✓       cool commit  skipped      trunk   push   7     4m34s    Feb 23, 2021\n-       cool commit  requested    trunk   push   8     4m34s    Feb 23, 2021\n-       cool commit  queued       trunk   push   9     4m34s    Feb 23, 2021\nX       cool commit  stale        trunk   push   10    4m34s    Feb 23, 2021\n\nFor details on a run, try: gh run view <run-id>\n",
+		},
 		{
 			name: "workflow selector",
 			opts: &ListOptions{
@@ -7,7 +7,7 @@ import (
 	"github.com/cli/cli/pkg/iostreams"
 )
 
-func RenderRunHeader(cs *iostreams.ColorScheme, run Run, ago, prNumber string) string {
+func RenderRunHeader(cs *iostreams.ColorScheme, run Run, ago, prNumber string, attempt uint64) string {
 	title := fmt.Sprintf("%s %s%s",
 		cs.Bold(run.HeadBranch), run.Name, prNumber)
 	symbol, symbolColor := Symbol(cs, run.Status, run.Conclusion)
@@ -16,6 +16,9 @@ func RenderRunHeader(cs *iostreams.ColorScheme, run Run, ago, prNumber string) s
 	header := ""
 	header += fmt.Sprintf("%s %s · %s\n", symbolColor(symbol), title, id)
 	header += fmt.Sprintf("Triggered via %s %s", run.Event, ago)
+	if attempt > 0 {
+		header += fmt.Sprintf("\nAttempt #%d of %d", attempt, run.Attempt)
+	}
 
 	return header
 }
@@ -56,6 +56,7 @@ type Run struct {
 	HeadSha        string `json:"head_sha"`
 	URL            string `json:"html_url"`
 	HeadRepository Repo   `json:"head_repository"`
+	Attempt        uint64 `json:"run_attempt"`
 }
 
 type Repo struct {
@@ -151,6 +152,24 @@ func GetAnnotations(client *api.Client, repo ghrepo.Interface, job Job) ([]Annot
 	return out, nil
 }
 
+// AllStatuses are the valid values for the `--status` flag of `gh run list`, covering both
+// workflow run statuses and conclusions as accepted by the REST API's `status` parameter.
+var AllStatuses = []string{
+	"queued",
+	"completed",
+	"in_progress",
+	"requested",
+	"waiting",
+	"action_required",
+	"cancelled",
+	"failure",
+	"neutral",
+	"skipped",
+	"stale",
+	"success",
+	"timed_out",
+}
+
 func IsFailureState(c Conclusion) bool {
 	switch c {
 	case ActionRequired, Failure, StartupFailure, TimedOut:
@@ -167,7 +186,7 @@ type RunsPayload struct {
 
 func GetRunsWithFilter(client *api.Client, repo ghrepo.Interface, limit int, f func(Run) bool) ([]Run, error) {
 	path := fmt.Sprintf("repos/%s/actions/runs", ghrepo.FullName(repo))
-	runs, err := getRuns(client, repo, path, 50)
+	runs, err := getRuns(client, repo, path, 50, "", "", "")
 	if err != nil {
 		return nil, err
 	}
@@ -184,17 +203,22 @@ func GetRunsWithFilter(client *api.Client, repo ghrepo.Interface, limit int, f f
 	return filtered, nil
 }
 
-func GetRunsByWorkflow(client *api.Client, repo ghrepo.Interface, limit int, workflowID int64) ([]Run, error) {
+func GetRunsByWorkflow(client *api.Client, repo ghrepo.Interface, limit int, workflowID int64, created, branch, status string) ([]Run, error) {
 	path := fmt.Sprintf("repos/%s/actions/workflows/%d/runs", ghrepo.FullName(repo), workflowID)
-	return getRuns(client, repo, path, limit)
+	return getRuns(client, repo, path, limit, created, branch, status)
 }
 
 func GetRuns(client *api.Client, repo ghrepo.Interface, limit int) ([]Run, error) {
 	path := fmt.Sprintf("repos/%s/actions/runs", ghrepo.FullName(repo))
-	return getRuns(client, repo, path, limit)
+	return getRuns(client, repo, path, limit, "", "", "")
+}
+
+func GetRunsWithCreatedFilter(client *api.Client, repo ghrepo.Interface, limit int, created, branch, status string) ([]Run, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs", ghrepo.FullName(repo))
+	return getRuns(client, repo, path, limit, created, branch, status)
 }
 
-func getRuns(client *api.Client, repo ghrepo.Interface, path string, limit int) ([]Run, error) {
+func getRuns(client *api.Client, repo ghrepo.Interface, path string, limit int, created, branch, status string) ([]Run, error) {
 	perPage := limit
 	page := 1
 	if limit > 100 {
@@ -213,6 +237,15 @@ func getRuns(client *api.Client, repo ghrepo.Interface, path string, limit int)
 		query := parsed.Query()
 		query.Set("per_page", fmt.Sprintf("%d", perPage))
 		query.Set("page", fmt.Sprintf("%d", page))
+		if created != "" {
+			query.Set("created", created)
+		}
+		if branch != "" {
+			query.Set("branch", branch)
+		}
+		if status != "" {
+			query.Set("status", status)
+		}
 		parsed.RawQuery = query.Encode()
 		pagedPath := parsed.String()
 
@@ -295,6 +328,20 @@ func GetRun(client *api.Client, repo ghrepo.Interface, runID string) (*Run, erro
 	return &result, nil
 }
 
+// GetAttempt fetches a specific attempt of a run rather than its latest attempt.
+func GetAttempt(client *api.Client, repo ghrepo.Interface, runID string, attempt uint64) (*Run, error) {
+	var result Run
+
+	path := fmt.Sprintf("repos/%s/actions/runs/%s/attempts/%d", ghrepo.FullName(repo), runID, attempt)
+
+	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 type colorFunc func(string) string
 
 func Symbol(cs *iostreams.ColorScheme, status Status, conclusion Conclusion) (string, colorFunc) {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -72,10 +73,12 @@ type ViewOptions struct {
 
 	RunID      string
 	JobID      string
+	Attempt    uint64
 	Verbose    bool
 	ExitStatus bool
 	Log        bool
 	LogFailed  bool
+	LogLive    bool
 	Web        bool
 
 	Prompt bool
@@ -109,6 +112,12 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			# View the full log for a specific job
 			$ gh run view --log --job 456789
 
+			# Watch a specific job's log as it runs
+			$ gh run view --log-live --job 456789
+
+			# View a specific run attempt, if the run has been rerun
+			$ gh run view 12345 --attempt 2
+
 			# Exit non-zero if a run failed
 			$ gh run view 0451 --exit-status && echo "run pending or passed"
 		`),
@@ -138,8 +147,17 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				return &cmdutil.FlagError{Err: errors.New("specify only one of --web or --log")}
 			}
 
-			if opts.Log && opts.LogFailed {
-				return &cmdutil.FlagError{Err: errors.New("specify only one of --log or --log-failed")}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --log, --log-failed, or --log-live",
+				opts.Log,
+				opts.LogFailed,
+				opts.LogLive,
+			); err != nil {
+				return err
+			}
+
+			if opts.LogLive && opts.JobID == "" {
+				return &cmdutil.FlagError{Err: errors.New("argument required: --job required when using --log-live")}
 			}
 
 			if runF != nil {
@@ -152,8 +170,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	// TODO should we try and expose pending via another exit code?
 	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if run failed")
 	cmd.Flags().StringVarP(&opts.JobID, "job", "j", "", "View a specific job ID from a run")
+	cmd.Flags().Uint64Var(&opts.Attempt, "attempt", 0, "The run attempt number")
 	cmd.Flags().BoolVar(&opts.Log, "log", false, "View full log for either a run or specific job")
 	cmd.Flags().BoolVar(&opts.LogFailed, "log-failed", false, "View the log for any failed steps in a run or specific job")
+	cmd.Flags().BoolVar(&opts.LogLive, "log-live", false, "View live log output for an in-progress job")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open run in the browser")
 
 	return cmd
@@ -213,6 +233,24 @@ func runView(opts *ViewOptions) error {
 		return fmt.Errorf("failed to get run: %w", err)
 	}
 
+	totalAttempts := run.Attempt
+	headerAttempt := uint64(0)
+	if opts.Attempt > 0 {
+		headerAttempt = opts.Attempt
+	} else if totalAttempts > 1 {
+		headerAttempt = totalAttempts
+	}
+
+	if opts.Attempt > 0 {
+		opts.IO.StartProgressIndicator()
+		run, err = shared.GetAttempt(client, repo, runID, opts.Attempt)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to get run attempt: %w", err)
+		}
+		run.Attempt = totalAttempts
+	}
+
 	if opts.Prompt {
 		opts.IO.StartProgressIndicator()
 		jobs, err = shared.GetJobs(client, repo, *run)
@@ -273,6 +311,14 @@ func runView(opts *ViewOptions) error {
 		return displayRunLog(opts.IO, jobs, opts.LogFailed)
 	}
 
+	if opts.LogLive {
+		if selectedJob.Status == shared.Completed {
+			return fmt.Errorf("job %d has already completed; for its full log, try: gh run view --log --job %d", selectedJob.ID, selectedJob.ID)
+		}
+
+		return streamJobLog(opts.IO, httpClient, client, repo, selectedJob)
+	}
+
 	prNumber := ""
 	number, err := shared.PullRequestForRun(client, repo, *run)
 	if err == nil {
@@ -310,7 +356,7 @@ func runView(opts *ViewOptions) error {
 	ago := opts.Now().Sub(run.CreatedAt)
 
 	fmt.Fprintln(out)
-	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, utils.FuzzyAgo(ago), prNumber))
+	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, utils.FuzzyAgo(ago), prNumber, headerAttempt))
 	fmt.Fprintln(out)
 
 	if len(jobs) == 0 && run.Conclusion == shared.Failure || run.Conclusion == shared.StartupFailure {
@@ -393,6 +439,45 @@ func getJob(client *api.Client, repo ghrepo.Interface, jobID string) (*shared.Jo
 	return &result, nil
 }
 
+// logLivePollInterval is how often streamJobLog re-fetches a running job's log.
+const logLivePollInterval = 3 * time.Second
+
+// streamJobLog polls the log for an in-progress job and writes newly available
+// output to out, similar to `tail -f`. It stops once the job reaches a
+// terminal state.
+func streamJobLog(io *iostreams.IOStreams, httpClient *http.Client, client *api.Client, repo ghrepo.Interface, job *shared.Job) error {
+	logURL := fmt.Sprintf("%srepos/%s/actions/jobs/%d/logs",
+		ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), job.ID)
+
+	var written int
+	for {
+		body, err := getLog(httpClient, logURL)
+		if err != nil {
+			return fmt.Errorf("failed to get job log: %w", err)
+		}
+		content, err := ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return err
+		}
+
+		if len(content) > written {
+			fmt.Fprint(io.Out, string(content[written:]))
+			written = len(content)
+		}
+
+		job, err = getJob(client, repo, strconv.FormatInt(job.ID, 10))
+		if err != nil {
+			return fmt.Errorf("failed to get job: %w", err)
+		}
+		if job.Status == shared.Completed {
+			return nil
+		}
+
+		time.Sleep(logLivePollInterval)
+	}
+}
+
 func getLog(httpClient *http.Client, logURL string) (io.ReadCloser, error) {
 	req, err := http.NewRequest("GET", logURL, nil)
 	if err != nil {
@@ -69,6 +69,25 @@ func TestNewCmdView(t *testing.T) {
 			cli:      "--log --log-failed",
 			wantsErr: true,
 		},
+		{
+			name:     "disallow log and log-live",
+			tty:      true,
+			cli:      "--log --log-live --job 1234",
+			wantsErr: true,
+		},
+		{
+			name:     "log-live without job id",
+			cli:      "--log-live",
+			wantsErr: true,
+		},
+		{
+			name: "log-live passed",
+			cli:  "--log-live --job 1234",
+			wants: ViewOptions{
+				JobID:   "1234",
+				LogLive: true,
+			},
+		},
 		{
 			name: "exit status",
 			cli:  "--exit-status 1234",
@@ -116,6 +135,14 @@ func TestNewCmdView(t *testing.T) {
 				JobID: "4567",
 			},
 		},
+		{
+			name: "attempt passed",
+			cli:  "1234 --attempt 3",
+			wants: ViewOptions{
+				RunID:   "1234",
+				Attempt: 3,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -150,9 +177,12 @@ func TestNewCmdView(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.wants.JobID, gotOpts.JobID)
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Verbose, gotOpts.Verbose)
+			assert.Equal(t, tt.wants.Attempt, gotOpts.Attempt)
+			assert.Equal(t, tt.wants.LogLive, gotOpts.LogLive)
 		})
 	}
 }
@@ -209,6 +239,41 @@ func TestViewRun(t *testing.T) {
 			},
 			wantOut: "\n✓ trunk successful #2898 · 3\nTriggered via push about 59 minutes ago\n\nJOBS\n✓ cool job in 4m34s (ID 10)\n\nFor more information about a job, try: gh run view --job=<job-id>\nView this run on GitHub: https://github.com/runs/3\n",
 		},
+		{
+			name: "with specific attempt",
+			tty:  true,
+			opts: &ViewOptions{
+				RunID:   "3",
+				Attempt: 2,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				rerunRun := shared.SuccessfulRun
+				rerunRun.Attempt = 3
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(rerunRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/attempts/2"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/artifacts"),
+					httpmock.StringResponse(`{}`))
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestForRun`),
+					httpmock.StringResponse(``))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.SuccessfulJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/10/annotations"),
+					httpmock.JSONResponse([]shared.Annotation{}))
+			},
+			wantOut: "\n✓ trunk successful · 3\nTriggered via push about 59 minutes ago\nAttempt #2 of 3\n\nJOBS\n✓ cool job in 4m34s (ID 10)\n\nFor more information about a job, try: gh run view --job=<job-id>\nView this run on GitHub: https://github.com/runs/3\n",
+		},
 		{
 			name: "exit status, failed run",
 			opts: &ViewOptions{
@@ -648,6 +713,37 @@ func TestViewRun(t *testing.T) {
 			wantErr: true,
 			errMsg:  "job 20 is still in progress; logs will be available when it is complete",
 		},
+		{
+			name: "noninteractive with job log-live",
+			opts: &ViewOptions{
+				JobID:   "20",
+				LogLive: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20"),
+					httpmock.JSONResponse(shared.Job{
+						ID:     20,
+						Status: shared.InProgress,
+						RunID:  2,
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+					httpmock.JSONResponse(shared.TestRun("in progress", 2, shared.InProgress, "")))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20/logs"),
+					httpmock.StringResponse("line one\nline two\n"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20"),
+					httpmock.JSONResponse(shared.Job{
+						ID:         20,
+						Status:     shared.Completed,
+						Conclusion: shared.Success,
+						RunID:      2,
+					}))
+			},
+			wantOut: "line one\nline two\n",
+		},
 		{
 			name: "noninteractive with job",
 			opts: &ViewOptions{
@@ -221,7 +221,11 @@ func renderRun(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run
 
 	fmt.Fprintln(out, cs.Boldf("Refreshing run status every %d seconds. Press Ctrl+C to quit.", opts.Interval))
 	fmt.Fprintln(out)
-	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, utils.FuzzyAgo(ago), prNumber))
+	headerAttempt := uint64(0)
+	if run.Attempt > 1 {
+		headerAttempt = run.Attempt
+	}
+	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, utils.FuzzyAgo(ago), prNumber, headerAttempt))
 	fmt.Fprintln(out)
 
 	if len(jobs) == 0 {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -19,11 +20,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// SecretFields lists the fields of Secret that are available to the --json flag.
+var SecretFields = []string{
+	"name",
+	"createdAt",
+	"updatedAt",
+	"visibility",
+	"selectedRepositoriesCount",
+}
+
 type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	Config     func() (config.Config, error)
 	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
 
 	OrgName string
 	EnvName string
@@ -59,6 +70,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "List secrets for an organization")
 	cmd.Flags().StringVarP(&opts.EnvName, "env", "e", "", "List secrets for an environment")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, SecretFields)
 
 	return cmd
 }
@@ -108,6 +120,10 @@ func listRun(opts *ListOptions) error {
 		return fmt.Errorf("failed to get secrets: %w", err)
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO.Out, secrets, opts.IO.ColorEnabled())
+	}
+
 	tp := utils.NewTablePrinter(opts.IO)
 	for _, secret := range secrets {
 		tp.AddField(secret.Name, nil, nil)
@@ -136,12 +152,32 @@ func listRun(opts *ListOptions) error {
 
 type Secret struct {
 	Name             string
+	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
 	Visibility       shared.Visibility
 	SelectedReposURL string `json:"selected_repositories_url"`
 	NumSelectedRepos int
 }
 
+func (s *Secret) ExportData(fields []string) *map[string]interface{} {
+	v := reflect.ValueOf(s).Elem()
+	data := map[string]interface{}{}
+
+	for _, f := range fields {
+		switch f {
+		case "selectedRepositoriesCount":
+			data[f] = s.NumSelectedRepos
+		default:
+			sf := v.FieldByNameFunc(func(name string) bool {
+				return strings.EqualFold(f, name)
+			})
+			data[f] = sf.Interface()
+		}
+	}
+
+	return &data
+}
+
 func fmtVisibility(s Secret) string {
 	switch s.Visibility {
 	case shared.All:
@@ -238,6 +238,51 @@ func Test_listRun(t *testing.T) {
 	}
 }
 
+func Test_listRun_json(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	t0, _ := time.Parse("2006-01-02", "1988-10-11")
+	payload := secretsPayload{
+		Secrets: []*Secret{
+			{
+				Name:       "SECRET_ONE",
+				CreatedAt:  t0,
+				UpdatedAt:  t0,
+				Visibility: shared.All,
+			},
+		},
+	}
+	reg.Register(httpmock.REST("GET", "repos/owner/repo/actions/secrets"), httpmock.JSONResponse(payload))
+
+	io, _, stdout, stderr := iostreams.Test()
+
+	f := &cmdutil.Factory{
+		IOStreams: io,
+		Config: func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("owner/repo")
+		},
+	}
+
+	cmd := NewCmdList(f, nil)
+	cmd.SetArgs([]string{"--json", "name,createdAt,updatedAt,visibility"})
+	cmd.SetIn(&bytes.Buffer{})
+	cmd.SetOut(ioutil.Discard)
+	cmd.SetErr(ioutil.Discard)
+
+	_, err := cmd.ExecuteC()
+	assert.NoError(t, err)
+
+	assert.JSONEq(t, `[{"createdAt":"1988-10-11T00:00:00Z","name":"SECRET_ONE","updatedAt":"1988-10-11T00:00:00Z","visibility":"all"}]`, stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func Test_getSecrets_pagination(t *testing.T) {
 	var requests []*http.Request
 	var client testClient = func(req *http.Request) (*http.Response, error) {
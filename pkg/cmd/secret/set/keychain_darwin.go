@@ -0,0 +1,17 @@
+package set
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+)
+
+func readKeychainItem(item string) ([]byte, error) {
+	cmd := exec.Command("security", "find-generic-password", "-s", item, "-w")
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("could not read %q from Keychain: %w", item, err)
+	}
+	return bytes.TrimRight(stdout.Bytes(), "\n"), nil
+}
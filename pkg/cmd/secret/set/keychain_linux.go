@@ -0,0 +1,17 @@
+package set
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+)
+
+func readKeychainItem(item string) ([]byte, error) {
+	cmd := exec.Command("secret-tool", "lookup", "label", item)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("could not read %q from the secret service (is libsecret's secret-tool installed?): %w", item, err)
+	}
+	return bytes.TrimRight(stdout.Bytes(), "\n"), nil
+}
@@ -0,0 +1,9 @@
+// +build !darwin,!linux,!windows
+
+package set
+
+import "fmt"
+
+func readKeychainItem(item string) ([]byte, error) {
+	return nil, fmt.Errorf("--keychain-item is not supported on this platform")
+}
@@ -0,0 +1,55 @@
+package set
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+const credTypeGeneric = 1
+
+type credential struct {
+	flags              uint32
+	credType           uint32
+	targetName         *uint16
+	comment            *uint16
+	lastWritten        syscall.Filetime
+	credentialBlobSize uint32
+	credentialBlob     *byte
+	persist            uint32
+	attributeCount     uint32
+	attributes         uintptr
+	targetAlias        *uint16
+	userName           *uint16
+}
+
+var (
+	advapi32 = syscall.NewLazyDLL("advapi32.dll")
+	credRead = advapi32.NewProc("CredReadW")
+	credFree = advapi32.NewProc("CredFree")
+)
+
+// readKeychainItem looks up a generic credential stored in Windows Credential
+// Manager under the given target name.
+func readKeychainItem(item string) ([]byte, error) {
+	targetName, err := syscall.UTF16PtrFromString(item)
+	if err != nil {
+		return nil, err
+	}
+
+	var cred *credential
+	ret, _, _ := credRead.Call(
+		uintptr(unsafe.Pointer(targetName)),
+		uintptr(credTypeGeneric),
+		0,
+		uintptr(unsafe.Pointer(&cred)),
+	)
+	if ret == 0 {
+		return nil, fmt.Errorf("could not read %q from Credential Manager", item)
+	}
+	defer credFree.Call(uintptr(unsafe.Pointer(cred)))
+
+	blob := make([]byte, cred.credentialBlobSize)
+	copy(blob, (*[1 << 20]byte)(unsafe.Pointer(cred.credentialBlob))[:cred.credentialBlobSize])
+	return blob, nil
+}
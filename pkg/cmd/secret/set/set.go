@@ -37,6 +37,7 @@ type SetOptions struct {
 	Body            string
 	Visibility      string
 	RepositoryNames []string
+	KeychainItem    string
 }
 
 func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command {
@@ -68,6 +69,9 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 
 			Set organization level secret visible only to certain repositories
 			$ gh secret set MYSECRET -bval --org=anOrg --repos="repo1,repo2,repo3"
+
+			Use the OS keychain as the source of the secret value
+			$ gh secret set MYSECRET --keychain-item=anItem
 `),
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
@@ -83,6 +87,10 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive("specify only one of `--body` or `--keychain-item`", opts.Body != "", opts.KeychainItem != ""); err != nil {
+				return err
+			}
+
 			opts.SecretName = args[0]
 
 			err := validSecretName(opts.SecretName)
@@ -128,6 +136,7 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 	cmd.Flags().StringVarP(&opts.Visibility, "visibility", "v", "private", "Set visibility for an organization secret: `all`, `private`, or `selected`")
 	cmd.Flags().StringSliceVarP(&opts.RepositoryNames, "repos", "r", []string{}, "List of repository names for `selected` visibility")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "A value for the secret. Reads from STDIN if not specified.")
+	cmd.Flags().StringVar(&opts.KeychainItem, "keychain-item", "", "Read the secret value from the OS keychain (Keychain on macOS, libsecret on Linux, Credential Manager on Windows)")
 
 	return cmd
 }
@@ -228,6 +237,10 @@ func validSecretName(name string) error {
 }
 
 func getBody(opts *SetOptions) ([]byte, error) {
+	if opts.KeychainItem != "" {
+		return readKeychainItem(opts.KeychainItem)
+	}
+
 	if opts.Body == "" {
 		if opts.IO.CanPrompt() {
 			err := prompt.SurveyAskOne(&survey.Password{
@@ -121,6 +121,21 @@ func TestNewCmdSet(t *testing.T) {
 				OrgName:    "coolOrg",
 			},
 		},
+		{
+			name: "keychain item",
+			cli:  `cool_secret --keychain-item anItem`,
+			wants: SetOptions{
+				SecretName:   "cool_secret",
+				Visibility:   shared.Private,
+				KeychainItem: "anItem",
+				OrgName:      "",
+			},
+		},
+		{
+			name:     "body and keychain item",
+			cli:      `cool_secret -b"cool" --keychain-item anItem`,
+			wantsErr: true,
+		},
 		{
 			name:     "bad name prefix",
 			cli:      `GITHUB_SECRET -b"cool"`,
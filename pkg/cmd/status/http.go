@@ -0,0 +1,113 @@
+package status
+
+import (
+	"time"
+
+	"github.com/cli/cli/api"
+)
+
+// StatusItem is an issue or pull request surfaced by one of the searches behind `gh status`.
+type StatusItem struct {
+	Type       string
+	Number     int
+	Title      string
+	URL        string
+	UpdatedAt  time.Time
+	Repository statusItemRepository
+}
+
+type statusItemRepository struct {
+	NameWithOwner string
+}
+
+// StatusItemFields lists the attributes of a StatusItem that can be selected with `--json`.
+var StatusItemFields = []string{"type", "number", "title", "url", "updatedAt", "repository"}
+
+func (s *StatusItem) ExportData(fields []string) *map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "type":
+			data[f] = s.Type
+		case "number":
+			data[f] = s.Number
+		case "title":
+			data[f] = s.Title
+		case "url":
+			data[f] = s.URL
+		case "updatedAt":
+			data[f] = s.UpdatedAt
+		case "repository":
+			data[f] = s.Repository.NameWithOwner
+		}
+	}
+	return &data
+}
+
+// statusSearchLimit caps how many results are fetched per search; `gh status` is meant to be a
+// quick overview, not an exhaustive listing.
+const statusSearchLimit = 25
+
+// searchStatusItems runs a single search query across all of the viewer's accessible repositories
+// and returns the matching issues and pull requests.
+func searchStatusItems(client *api.Client, hostname, searchQuery string) ([]StatusItem, error) {
+	query := `
+	query StatusSearch($query: String!, $limit: Int!) {
+		search(type: ISSUE, query: $query, first: $limit) {
+			nodes {
+				__typename
+				... on Issue {
+					number
+					title
+					url
+					updatedAt
+					repository { nameWithOwner }
+				}
+				... on PullRequest {
+					number
+					title
+					url
+					updatedAt
+					repository { nameWithOwner }
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"query": searchQuery,
+		"limit": statusSearchLimit,
+	}
+
+	type responseNode struct {
+		Typename   string `json:"__typename"`
+		Number     int
+		Title      string
+		URL        string
+		UpdatedAt  time.Time
+		Repository statusItemRepository
+	}
+	var result struct {
+		Search struct {
+			Nodes []responseNode
+		}
+	}
+
+	err := client.GraphQL(hostname, query, variables, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]StatusItem, len(result.Search.Nodes))
+	for i, n := range result.Search.Nodes {
+		items[i] = StatusItem{
+			Type:       n.Typename,
+			Number:     n.Number,
+			Title:      n.Title,
+			URL:        n.URL,
+			UpdatedAt:  n.UpdatedAt,
+			Repository: n.Repository,
+		}
+	}
+	return items, nil
+}
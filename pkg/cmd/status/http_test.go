@@ -0,0 +1,46 @@
+package status
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_searchStatusItems(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(httpmock.GraphQL(`query StatusSearch\b`), httpmock.StringResponse(`
+	{ "data": { "search": { "nodes": [
+		{
+			"__typename": "Issue",
+			"number": 1,
+			"title": "an issue",
+			"url": "https://github.com/o/r/issues/1",
+			"updatedAt": "2020-07-30T15:24:28Z",
+			"repository": { "nameWithOwner": "o/r" }
+		},
+		{
+			"__typename": "PullRequest",
+			"number": 2,
+			"title": "a pull request",
+			"url": "https://github.com/o/r/pull/2",
+			"updatedAt": "2020-07-30T15:24:28Z",
+			"repository": { "nameWithOwner": "o/r" }
+		}
+	] } } }`))
+
+	httpClient := &http.Client{Transport: reg}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	items, err := searchStatusItems(apiClient, "github.com", "is:open assignee:@me")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(items))
+	assert.Equal(t, "Issue", items[0].Type)
+	assert.Equal(t, 1, items[0].Number)
+	assert.Equal(t, "o/r", items[0].Repository.NameWithOwner)
+	assert.Equal(t, "PullRequest", items[1].Type)
+}
@@ -0,0 +1,156 @@
+package status
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/config"
+	prShared "github.com/cli/cli/pkg/cmd/pr/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/text"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type StatusOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (config.Config, error)
+	IO         *iostreams.IOStreams
+
+	Org      string
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
+	opts := &StatusOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Print information about relevant issues, pull requests, and review requests",
+		Long: heredoc.Doc(`
+			The status command prints information about your work on GitHub across all of
+			the repositories you can access: issues and pull requests assigned to you, pull
+			requests awaiting your review, and issues or pull requests that mention you.
+		`),
+		Args: cmdutil.NoArgsQuoteReminder,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return statusRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Filter results for an organization")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, StatusItemFields)
+
+	return cmd
+}
+
+type statusSection struct {
+	key   string
+	title string
+	query string
+}
+
+type statusResult struct {
+	items []StatusItem
+	err   error
+}
+
+func statusRun(opts *StatusOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	hostname, err := cfg.DefaultHost()
+	if err != nil {
+		return err
+	}
+
+	scope := ""
+	if opts.Org != "" {
+		scope = fmt.Sprintf("org:%s ", opts.Org)
+	}
+
+	sections := []statusSection{
+		{"assigned", "Issues assigned to you", scope + "is:open is:issue assignee:@me"},
+		{"reviewRequested", "Pull requests needing your review", scope + "is:open is:pr review-requested:@me"},
+		{"mentioned", "Issues and pull requests mentioning you", scope + "is:open mentions:@me"},
+	}
+
+	results := make([]statusResult, len(sections))
+	var wg sync.WaitGroup
+	for i := range sections {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			items, err := searchStatusItems(apiClient, hostname, sections[i].query)
+			results[i] = statusResult{items: items, err: err}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, r := range results {
+		if r.err != nil {
+			return r.err
+		}
+	}
+
+	if opts.Exporter != nil {
+		data := map[string]interface{}{}
+		for i, section := range sections {
+			data[section.key] = results[i].items
+		}
+		return opts.Exporter.Write(opts.IO.Out, data, opts.IO.ColorEnabled())
+	}
+
+	err = opts.IO.StartPager()
+	if err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	for i, section := range sections {
+		prShared.PrintHeader(opts.IO, section.title)
+		if len(results[i].items) > 0 {
+			printStatusItems(opts.IO, results[i].items)
+		} else {
+			prShared.PrintMessage(opts.IO, "  Nothing to show")
+		}
+		fmt.Fprintln(opts.IO.Out)
+	}
+
+	return nil
+}
+
+func printStatusItems(io *iostreams.IOStreams, items []StatusItem) {
+	cs := io.ColorScheme()
+	table := utils.NewTablePrinter(io)
+	for _, item := range items {
+		itemNum := fmt.Sprintf("#%d", item.Number)
+		typeColor := cs.Green
+		if item.Type == "PullRequest" {
+			typeColor = cs.Magenta
+		}
+		table.AddField(itemNum, nil, typeColor)
+		table.AddField(text.Truncate(70, text.ReplaceExcessiveWhitespace(item.Title)), nil, nil)
+		table.AddField(item.Repository.NameWithOwner, nil, cs.Gray)
+		table.EndRow()
+	}
+	_ = table.Render()
+}
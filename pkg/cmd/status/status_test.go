@@ -0,0 +1,141 @@
+package status
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/internal/config"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/httpmock"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+// matchSearchQuery matches a StatusSearch request whose `query` variable contains the given
+// substring, since all three of `gh status`'s searches share the same GraphQL operation name and
+// otherwise race with each other under httpmock's in-order stub matching.
+func matchSearchQuery(contains string) httpmock.Matcher {
+	return func(req *http.Request) bool {
+		if req.URL.Path != "/graphql" && req.URL.Path != "/api/graphql" {
+			return false
+		}
+		var body struct {
+			Query     string
+			Variables struct {
+				Query string
+			}
+		}
+		bodyCopy := &bytes.Buffer{}
+		b, _ := ioutil.ReadAll(io.TeeReader(req.Body, bodyCopy))
+		req.Body = ioutil.NopCloser(bodyCopy)
+		_ = json.Unmarshal(b, &body)
+		return strings.Contains(body.Query, "StatusSearch") && strings.Contains(body.Variables.Query, contains)
+	}
+}
+
+func TestNewCmdStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		cli  string
+		want StatusOptions
+	}{
+		{
+			name: "no arguments",
+			cli:  "",
+			want: StatusOptions{Org: ""},
+		},
+		{
+			name: "org",
+			cli:  "--org acme",
+			want: StatusOptions{Org: "acme"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *StatusOptions
+			cmd := NewCmdStatus(f, func(opts *StatusOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.Org, gotOpts.Org)
+		})
+	}
+}
+
+func Test_statusRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *StatusOptions
+		stubs   func(*httpmock.Registry)
+		wantOut string
+	}{
+		{
+			name: "nothing assigned",
+			opts: &StatusOptions{},
+			stubs: func(reg *httpmock.Registry) {
+				empty := `{ "data": { "search": { "nodes": [] } } }`
+				reg.Register(matchSearchQuery("assignee:@me"), httpmock.StringResponse(empty))
+				reg.Register(matchSearchQuery("review-requested:@me"), httpmock.StringResponse(empty))
+				reg.Register(matchSearchQuery("mentions:@me"), httpmock.StringResponse(empty))
+			},
+			wantOut: "Issues assigned to you\n  Nothing to show\n\nPull requests needing your review\n  Nothing to show\n\nIssues and pull requests mentioning you\n  Nothing to show\n\n",
+		},
+		{
+			name: "mixed results",
+			opts: &StatusOptions{},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(matchSearchQuery("assignee:@me"), httpmock.StringResponse(`{ "data": { "search": { "nodes": [
+					{ "__typename": "Issue", "number": 1, "title": "an issue", "url": "https://github.com/o/r/issues/1", "updatedAt": "2020-07-30T15:24:28Z", "repository": { "nameWithOwner": "o/r" } }
+				] } } }`))
+				reg.Register(matchSearchQuery("review-requested:@me"), httpmock.StringResponse(`{ "data": { "search": { "nodes": [
+					{ "__typename": "PullRequest", "number": 2, "title": "a pr", "url": "https://github.com/o/r/pull/2", "updatedAt": "2020-07-30T15:24:28Z", "repository": { "nameWithOwner": "o/r" } }
+				] } } }`))
+				reg.Register(matchSearchQuery("mentions:@me"), httpmock.StringResponse(`{ "data": { "search": { "nodes": [] } } }`))
+			},
+			wantOut: "Issues assigned to you\n#1\tan issue\to/r\n\nPull requests needing your review\n#2\ta pr\to/r\n\nIssues and pull requests mentioning you\n  Nothing to show\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		reg := &httpmock.Registry{}
+		tt.stubs(reg)
+
+		tt.opts.HttpClient = func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		}
+		tt.opts.Config = func() (config.Config, error) {
+			return config.NewBlankConfig(), nil
+		}
+
+		io, _, stdout, _ := iostreams.Test()
+		io.SetStdoutTTY(false)
+		tt.opts.IO = io
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := statusRun(tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
 	"github.com/cli/cli/internal/ghrepo"
 	"github.com/cli/cli/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/pkg/cmdutil"
@@ -304,9 +305,7 @@ func runRun(opts *RunOptions) error {
 		return fmt.Errorf("failed to serialize workflow inputs: %w", err)
 	}
 
-	body := bytes.NewReader(requestByte)
-
-	err = client.REST(repo.RepoHost(), "POST", path, body, nil)
+	runURL, err := dispatchWorkflow(client, repo, path, requestByte)
 	if err != nil {
 		return fmt.Errorf("could not create workflow dispatch event: %w", err)
 	}
@@ -319,6 +318,11 @@ func runRun(opts *RunOptions) error {
 
 		fmt.Fprintln(out)
 
+		if runURL != "" {
+			fmt.Fprintf(out, "To see the run, visit: %s\n", cs.Bold(runURL))
+			fmt.Fprintln(out)
+		}
+
 		fmt.Fprintf(out, "To see runs for this workflow, try: %s\n",
 			cs.Boldf("gh run list --workflow=%s", workflow.Base()))
 	}
@@ -326,6 +330,28 @@ func runRun(opts *RunOptions) error {
 	return nil
 }
 
+// dispatchWorkflow triggers the workflow_dispatch event and returns the run's URL, if the
+// API response includes a Location header pointing at the newly created run.
+func dispatchWorkflow(client *api.Client, repo ghrepo.Interface, path string, body []byte) (string, error) {
+	req, err := http.NewRequest("POST", ghinstance.RESTPrefix(repo.RepoHost())+path, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := client.HTTP().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp.Header.Get("Location"), nil
+	}
+
+	return "", api.HandleHTTPError(resp)
+}
+
 type WorkflowInput struct {
 	Required    bool
 	Default     string
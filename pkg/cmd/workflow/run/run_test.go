@@ -414,6 +414,32 @@ jobs:
 			},
 			httpStubs: stubs,
 		},
+		{
+			name: "prints run URL from Location header",
+			tty:  true,
+			opts: &RunOptions{
+				Selector:  "workflow.yml",
+				JSONInput: `{"name":"scully"}`,
+			},
+			wantBody: map[string]interface{}{
+				"inputs": map[string]interface{}{
+					"name": "scully",
+				},
+				"ref": "trunk",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/workflow.yml"),
+					httpmock.JSONResponse(shared.Workflow{
+						Path: ".github/workflows/workflow.yml",
+						ID:   12345,
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
+					httpmock.HeaderResponse(204, map[string]string{"Location": "https://github.com/OWNER/REPO/actions/runs/1"}, ""))
+			},
+			wantOut: "✓ Created workflow_dispatch event for workflow.yml at trunk\n\nTo see the run, visit: https://github.com/OWNER/REPO/actions/runs/1\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
+		},
 		{
 			name: "nontty good input fields",
 			opts: &RunOptions{
@@ -615,6 +641,39 @@ jobs:
 			},
 			wantOut: "✓ Created workflow_dispatch event for workflow.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
 		},
+		{
+			name: "fuzzy name match",
+			opts: &RunOptions{
+				Selector:  "triage",
+				JSONInput: `{"name":"scully"}`,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/triage"),
+					httpmock.StatusStringResponse(404, "not found"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							{
+								Name:  "Triage Issues",
+								ID:    12345,
+								State: shared.Active,
+								Path:  ".github/workflows/workflow.yml",
+							},
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
+					httpmock.StatusStringResponse(204, "cool"))
+			},
+			wantBody: map[string]interface{}{
+				"inputs": map[string]interface{}{
+					"name": "scully",
+				},
+				"ref": "trunk",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -152,23 +152,33 @@ func getWorkflowsByName(client *api.Client, repo ghrepo.Interface, name string,
 	if err != nil {
 		return nil, fmt.Errorf("couldn't fetch workflows for %s: %w", ghrepo.FullName(repo), err)
 	}
-	filtered := []Workflow{}
 
+	inDesiredState := []Workflow{}
 	for _, workflow := range workflows {
-		desiredState := false
 		for _, state := range states {
 			if workflow.State == state {
-				desiredState = true
+				inDesiredState = append(inDesiredState, workflow)
 				break
 			}
 		}
+	}
 
-		if !desiredState {
-			continue
+	filtered := []Workflow{}
+	for _, workflow := range inDesiredState {
+		if strings.EqualFold(workflow.Name, name) {
+			filtered = append(filtered, workflow)
 		}
+	}
 
-		// TODO consider fuzzy or prefix match
-		if strings.EqualFold(workflow.Name, name) {
+	if len(filtered) > 0 {
+		return filtered, nil
+	}
+
+	// No exact match; fall back to a fuzzy, case-insensitive substring match
+	// against the workflow name or the base name of its file.
+	for _, workflow := range inDesiredState {
+		if strings.Contains(strings.ToLower(workflow.Name), strings.ToLower(name)) ||
+			strings.Contains(strings.ToLower(workflow.Base()), strings.ToLower(name)) {
 			filtered = append(filtered, workflow)
 		}
 	}
@@ -334,6 +334,27 @@ func TestViewRun(t *testing.T) {
 			wantErr:    true,
 			wantErrOut: "could not find workflow file flow.yml, try specifying a branch or tag using `--ref`",
 		},
+		{
+			name: "workflow with yaml not found on ref",
+			tty:  true,
+			opts: &ViewOptions{
+				Selector: "123",
+				Ref:      "feature-branch",
+				YAML:     true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(aWorkflow),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/flow.yml"),
+					httpmock.StatusStringResponse(404, "not Found"),
+				)
+			},
+			wantErr:    true,
+			wantErrOut: "could not find workflow file flow.yml on feature-branch, try specifying a different ref",
+		},
 		{
 			name: "workflow with yaml and ref",
 			tty:  true,
@@ -17,6 +17,8 @@ const (
 
 	UpdatedAt SortField = "updated"
 	CreatedAt SortField = "created"
+	Comments  SortField = "comments"
+	Reactions SortField = "reactions"
 
 	Issue       EntityType = "issue"
 	PullRequest EntityType = "pr"
@@ -36,6 +38,7 @@ func NewQuery() *Query {
 type Query struct {
 	repo  string
 	owner string
+	org   string
 	sort  string
 	query string
 
@@ -44,15 +47,20 @@ type Query struct {
 	baseBranch string
 	headBranch string
 	labels     []string
-	assignee   string
+	assignees  []string
+	noAssignee bool
 	author     string
 	mentions   string
 	milestone  string
 
 	language   string
+	topic      string
 	forkState  string
 	visibility string
 	isArchived *bool
+
+	created string
+	updated string
 }
 
 func (q *Query) InRepository(nameWithOwner string) {
@@ -63,6 +71,10 @@ func (q *Query) OwnedBy(owner string) {
 	q.owner = owner
 }
 
+func (q *Query) InOrganization(org string) {
+	q.org = org
+}
+
 func (q *Query) SortBy(field SortField, direction SortDirection) {
 	var dir string
 	switch direction {
@@ -94,8 +106,15 @@ func (q *Query) SetHeadBranch(name string) {
 	q.headBranch = name
 }
 
+// AssignedTo adds a user to filter issues/pull requests by. Calling it more than once
+// filters by any of the given users (OR semantics); the special value "none" matches
+// issues/pull requests with no assignee.
 func (q *Query) AssignedTo(user string) {
-	q.assignee = user
+	if strings.EqualFold(user, "none") {
+		q.noAssignee = true
+		return
+	}
+	q.assignees = append(q.assignees, user)
 }
 
 func (q *Query) AuthoredBy(user string) {
@@ -110,6 +129,18 @@ func (q *Query) InMilestone(title string) {
 	q.milestone = title
 }
 
+// CreatedRange sets the "created:" qualifier to the given value, e.g. ">=2021-03-01" or
+// "2021-03-01..2021-03-31".
+func (q *Query) CreatedRange(qualifier string) {
+	q.created = qualifier
+}
+
+// UpdatedRange sets the "updated:" qualifier to the given value, e.g. ">=2021-03-01" or
+// "2021-03-01..2021-03-31".
+func (q *Query) UpdatedRange(qualifier string) {
+	q.updated = qualifier
+}
+
 func (q *Query) AddLabel(name string) {
 	q.labels = append(q.labels, name)
 }
@@ -118,6 +149,10 @@ func (q *Query) SetLanguage(name string) {
 	q.language = name
 }
 
+func (q *Query) SetTopic(name string) {
+	q.topic = name
+}
+
 func (q *Query) SetVisibility(visibility RepoVisibility) {
 	q.visibility = string(visibility)
 }
@@ -140,6 +175,8 @@ func (q *Query) String() string {
 	// context
 	if q.repo != "" {
 		qs += fmt.Sprintf("repo:%s ", q.repo)
+	} else if q.org != "" {
+		qs += fmt.Sprintf("org:%s ", q.org)
 	} else if q.owner != "" {
 		qs += fmt.Sprintf("user:%s ", q.owner)
 	}
@@ -159,6 +196,9 @@ func (q *Query) String() string {
 	if q.language != "" {
 		qs += fmt.Sprintf("language:%s ", quote(q.language))
 	}
+	if q.topic != "" {
+		qs += fmt.Sprintf("topic:%s ", quote(q.topic))
+	}
 	if q.forkState != "" {
 		qs += fmt.Sprintf("fork:%s ", q.forkState)
 	}
@@ -167,8 +207,16 @@ func (q *Query) String() string {
 	}
 
 	// issues
-	if q.assignee != "" {
-		qs += fmt.Sprintf("assignee:%s ", q.assignee)
+	if q.noAssignee {
+		qs += "no:assignee "
+	} else if len(q.assignees) == 1 {
+		qs += fmt.Sprintf("assignee:%s ", q.assignees[0])
+	} else if len(q.assignees) > 1 {
+		terms := make([]string, len(q.assignees))
+		for i, assignee := range q.assignees {
+			terms[i] = fmt.Sprintf("assignee:%s", assignee)
+		}
+		qs += fmt.Sprintf("(%s) ", strings.Join(terms, " OR "))
 	}
 	for _, label := range q.labels {
 		qs += fmt.Sprintf("label:%s ", quote(label))
@@ -179,9 +227,17 @@ func (q *Query) String() string {
 	if q.mentions != "" {
 		qs += fmt.Sprintf("mentions:%s ", q.mentions)
 	}
-	if q.milestone != "" {
+	if strings.EqualFold(q.milestone, "none") {
+		qs += "no:milestone "
+	} else if q.milestone != "" {
 		qs += fmt.Sprintf("milestone:%s ", quote(q.milestone))
 	}
+	if q.created != "" {
+		qs += fmt.Sprintf("created:%s ", q.created)
+	}
+	if q.updated != "" {
+		qs += fmt.Sprintf("updated:%s ", q.updated)
+	}
 
 	// pull requests
 	if q.baseBranch != "" {
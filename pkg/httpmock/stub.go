@@ -83,6 +83,16 @@ func StatusStringResponse(status int, body string) Responder {
 	}
 }
 
+func HeaderResponse(status int, headers map[string]string, body string) Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		resp := httpResponse(status, req, bytes.NewBufferString(body))
+		for k, v := range headers {
+			resp.Header.Set(k, v)
+		}
+		return resp, nil
+	}
+}
+
 func JSONResponse(body interface{}) Responder {
 	return func(req *http.Request) (*http.Response, error) {
 		b, _ := json.Marshal(body)
@@ -151,5 +161,6 @@ func httpResponse(status int, req *http.Request, body io.Reader) *http.Response
 		StatusCode: status,
 		Request:    req,
 		Body:       ioutil.NopCloser(body),
+		Header:     http.Header{},
 	}
 }